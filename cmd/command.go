@@ -18,12 +18,14 @@
 package main
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/urfave/cli/v2"
 
 	"github.com/apache/skywalking-satellite/internal/satellite/boot"
 	"github.com/apache/skywalking-satellite/internal/satellite/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/replay"
 	"github.com/apache/skywalking-satellite/internal/satellite/tools"
 )
 
@@ -88,4 +90,95 @@ var (
 			return tools.GeneratePluginDoc(outputRootPath, menuFilePath, pluginFilePath)
 		},
 	}
+
+	cmdReplay = cli.Command{
+		Name:  "replay",
+		Usage: "replay the archived or queued data to the backend",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "config",
+				Aliases: []string{"c"},
+				Usage:   "Load configuration from `FILE`, the client and forwarders of the pipe are used to replay",
+				EnvVars: []string{"SATELLITE_CONFIG"},
+				Value:   "configs/satellite_config.yaml",
+			},
+			&cli.StringFlag{
+				Name:    "pipe",
+				Aliases: []string{"p"},
+				Usage:   "The pipe `NAME` to replay, could be empty when there is only one pipe",
+			},
+			&cli.StringSliceFlag{
+				Name:    "file",
+				Aliases: []string{"f"},
+				Usage:   "The archive `FILE` or directory written by the archive-file-forwarder",
+			},
+			&cli.BoolFlag{
+				Name:  "queue",
+				Usage: "Replay the events in the mmap queue of the pipe, please run it in the working directory of the satellite",
+			},
+			&cli.StringSliceFlag{
+				Name:  "type",
+				Usage: "Only replay the events of the `TYPE`, such as Logging and TracingType",
+			},
+			&cli.StringSliceFlag{
+				Name:  "service",
+				Usage: "Only replay the events of the `SERVICE`",
+			},
+			&cli.StringFlag{
+				Name:  "start",
+				Usage: "Only replay the events received at or after the `TIME` in RFC3339",
+			},
+			&cli.StringFlag{
+				Name:  "end",
+				Usage: "Only replay the events received before the `TIME` in RFC3339",
+			},
+			&cli.Float64Flag{
+				Name:  "rate",
+				Usage: "The max events per second, 0 means no limit",
+				Value: 1000,
+			},
+			&cli.IntFlag{
+				Name:  "batch_size",
+				Usage: "The max events count of a batch",
+				Value: 100,
+			},
+			&cli.IntFlag{
+				Name:  "retries",
+				Usage: "The retry times of a failed batch",
+				Value: 3,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			options := &replay.Options{
+				Pipe:      c.String("pipe"),
+				Files:     c.StringSlice("file"),
+				FromQueue: c.Bool("queue"),
+				Types:     c.StringSlice("type"),
+				Services:  c.StringSlice("service"),
+				Rate:      c.Float64("rate"),
+				BatchSize: c.Int("batch_size"),
+				Retries:   c.Int("retries"),
+			}
+			var err error
+			if options.Start, err = parseTime(c.String("start")); err != nil {
+				return err
+			}
+			if options.End, err = parseTime(c.String("end")); err != nil {
+				return err
+			}
+			cfg := config.Load(c.String("config"))
+			return replay.Start(cfg, options)
+		},
+	}
 )
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("illegal time %s: %v", value, err)
+	}
+	return t, nil
+}
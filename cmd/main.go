@@ -39,6 +39,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		&cmdStart,
 		&cmdDocs,
+		&cmdReplay,
 	}
 	app.Action = cli.ShowAppHelp
 	if err := app.Run(os.Args); err != nil {
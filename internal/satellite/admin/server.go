@@ -0,0 +1,165 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/tap"
+)
+
+const defaultTapDuration = 60
+
+// Config is the config of the admin server.
+type Config struct {
+	Address        string `mapstructure:"address"`          // The admin server address, the admin server is disabled when it is empty.
+	TapOutput      string `mapstructure:"tap_output"`       // The output of the tapped events, "stdout" or a file path.
+	TapMaxDuration int    `mapstructure:"tap_max_duration"` // The max duration of a tap, the time unit is second.
+}
+
+// Server is the admin HTTP server, which provides the operations of the running Satellite, such as tapping a pipe.
+type Server struct {
+	config *Config
+	pipes  map[string]bool
+	output io.WriteCloser
+	server *http.Server
+}
+
+// Start starts the admin server when the address is configured, the pipes are the names of the tappable pipes.
+func Start(config *Config, pipes []string) (*Server, error) {
+	if config == nil || config.Address == "" {
+		return nil, nil
+	}
+	output, err := tap.OpenOutput(config.TapOutput)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the tap output: %v", err)
+	}
+	s := &Server{
+		config: config,
+		pipes:  make(map[string]bool, len(pipes)),
+		output: output,
+	}
+	for _, pipe := range pipes {
+		s.pipes[pipe] = true
+	}
+	listener, err := net.Listen("tcp", config.Address)
+	if err != nil {
+		_ = output.Close()
+		return nil, fmt.Errorf("cannot listen the admin server address: %v", err)
+	}
+	s.server = &http.Server{
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	go func() {
+		log.Logger.WithField("addr", config.Address).Info("start the admin server")
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.Logger.WithField("addr", config.Address).Warnf("the admin server failure: %v", err)
+		}
+	}()
+	return s, nil
+}
+
+// Close stops the admin server and the running taps.
+func (s *Server) Close() error {
+	if s == nil {
+		return nil
+	}
+	for _, t := range tap.List() {
+		tap.Stop(t.Pipe)
+	}
+	err := s.server.Close()
+	if e := s.output.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /taps", s.listTaps)
+	mux.HandleFunc("POST /taps/{pipe}", s.startTap)
+	mux.HandleFunc("DELETE /taps/{pipe}", s.stopTap)
+	return mux
+}
+
+func (s *Server) listTaps(rsp http.ResponseWriter, _ *http.Request) {
+	writeJSON(rsp, http.StatusOK, tap.List())
+}
+
+// startTap starts to tap the pipe with the query parameters, such as "duration=60&sampling_rate=0.1&fields=type,data.service".
+func (s *Server) startTap(rsp http.ResponseWriter, req *http.Request) {
+	pipe := req.PathValue("pipe")
+	if !s.pipes[pipe] {
+		http.Error(rsp, fmt.Sprintf("the pipe %s is not found", pipe), http.StatusNotFound)
+		return
+	}
+	query := req.URL.Query()
+	duration, err := intParam(query.Get("duration"), defaultTapDuration)
+	if err != nil || duration <= 0 || duration > s.config.TapMaxDuration {
+		http.Error(rsp, fmt.Sprintf("the duration must be in (0, %d] seconds", s.config.TapMaxDuration), http.StatusBadRequest)
+		return
+	}
+	config := tap.PrinterConfig{SamplingRate: 1}
+	if rate := query.Get("sampling_rate"); rate != "" {
+		if config.SamplingRate, err = strconv.ParseFloat(rate, 64); err != nil {
+			http.Error(rsp, fmt.Sprintf("illegal sampling rate: %s", rate), http.StatusBadRequest)
+			return
+		}
+	}
+	if fields := query.Get("fields"); fields != "" {
+		config.Fields = strings.Split(fields, ",")
+	}
+	t, err := tap.Start(pipe, time.Duration(duration)*time.Second, config, s.output)
+	if err != nil {
+		http.Error(rsp, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(rsp, http.StatusOK, t)
+}
+
+func (s *Server) stopTap(rsp http.ResponseWriter, req *http.Request) {
+	if !tap.Stop(req.PathValue("pipe")) {
+		http.Error(rsp, fmt.Sprintf("the pipe %s is not tapped", req.PathValue("pipe")), http.StatusNotFound)
+		return
+	}
+	rsp.WriteHeader(http.StatusNoContent)
+}
+
+func intParam(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func writeJSON(rsp http.ResponseWriter, status int, value interface{}) {
+	rsp.Header().Set("Content-Type", "application/json")
+	rsp.WriteHeader(status)
+	if err := json.NewEncoder(rsp).Encode(value); err != nil {
+		log.Logger.Warnf("write the admin response failure: %v", err)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/admin"
 	"github.com/apache/skywalking-satellite/internal/satellite/config"
 	"github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	"github.com/apache/skywalking-satellite/internal/satellite/module/gatherer"
@@ -69,6 +70,16 @@ func Start(cfg *config.SatelliteConfig, shutdownHookTime time.Duration) error {
 		return fmt.Errorf("error in preparing the sharing plugins: %v", err)
 	}
 	defer sharing.Close()
+	// start the admin server to operate the pipes, such as tapping a pipe.
+	pipes := make([]string, 0, len(cfg.Pipes))
+	for _, p := range cfg.Pipes {
+		pipes = append(pipes, p.PipeCommonConfig.PipeName)
+	}
+	adminServer, err := admin.Start(cfg.Admin, pipes)
+	if err != nil {
+		return err
+	}
+	defer adminServer.Close()
 	// boot Satellite
 	if modules, err := initModules(cfg); err != nil {
 		return err
@@ -21,6 +21,7 @@ import (
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/admin"
 	gatherer "github.com/apache/skywalking-satellite/internal/satellite/module/gatherer/api"
 	processor "github.com/apache/skywalking-satellite/internal/satellite/module/processor/api"
 	sender "github.com/apache/skywalking-satellite/internal/satellite/module/sender/api"
@@ -33,6 +34,7 @@ type SatelliteConfig struct {
 	Pipes     []*PipeConfig     `mapstructure:"pipes"`
 	Sharing   *SharingConfig    `mapstructure:"sharing"`
 	Telemetry *telemetry.Config `mapstructure:"telemetry"`
+	Admin     *admin.Config     `mapstructure:"admin"`
 }
 
 // SharingConfig contains some plugins,which could be shared by every namespace. That is useful to reduce resources cost.
@@ -63,6 +65,10 @@ func NewDefaultSatelliteConfig() *SatelliteConfig {
 			Service:  "default_service",
 			Instance: "default_instance",
 		},
+		Admin: &admin.Config{
+			TapOutput:      "stdout",
+			TapMaxDuration: 600,
+		},
 		Sharing: &SharingConfig{
 			SharingCommonConfig: &config.CommonFields{
 				PipeName: "sharing",
@@ -61,3 +61,14 @@ func (o *Offset) String() string {
 	}
 	return fmt.Sprintf("%d_%s", o.Partition, o.Position)
 }
+
+// MetaSourcePeer is the meta key of the peer address which reported the event.
+const MetaSourcePeer = "source_peer"
+
+// SourcePeerMeta builds the event meta with the peer address, return nil when the address is unknown.
+func SourcePeerMeta(peer string) map[string]string {
+	if peer == "" {
+		return nil
+	}
+	return map[string]string{MetaSourcePeer: peer}
+}
@@ -0,0 +1,97 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package event
+
+import (
+	"time"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// The extension types are the data types not declared in the SniffData protocol yet.
+// Their values start from a large number to avoid conflicting with the declared types,
+// and they are stored in the queues and the archives, so never change or reuse a value.
+// The event of an extension type carries the serialized protocol message in the
+// segment bytes field, please use NewExtensionEvent and ExtensionData to access it.
+const (
+	// ZipkinSpansType carries the zipkin.proto3.ListOfSpans message.
+	ZipkinSpansType v1.SniffType = 1000
+	// OpenTelemetryTracesV1Type carries the opentelemetry.proto.collector.trace.v1.ExportTraceServiceRequest message.
+	OpenTelemetryTracesV1Type v1.SniffType = 1001
+	// OpenTelemetryLogsV1Type carries the opentelemetry.proto.collector.logs.v1.ExportLogsServiceRequest message.
+	OpenTelemetryLogsV1Type v1.SniffType = 1002
+	// BrowserPerfDataType carries the skywalking.v3.BrowserPerfData message.
+	BrowserPerfDataType v1.SniffType = 1003
+	// BrowserErrorLogType carries the skywalking.v3.BrowserErrorLog message.
+	BrowserErrorLogType v1.SniffType = 1004
+	// ZabbixRequestType carries the JSON of the Zabbix agent request, and the synchronous response carries the JSON of the Zabbix server response.
+	ZabbixRequestType v1.SniffType = 1005
+)
+
+// AnyType is the forward type of the forwarders which accept the events of all types.
+const AnyType v1.SniffType = -1
+
+var extensionTypeNames = map[v1.SniffType]string{
+	AnyType:                   "AnyType",
+	ZipkinSpansType:           "ZipkinSpansType",
+	OpenTelemetryTracesV1Type: "OpenTelemetryTracesV1Type",
+	OpenTelemetryLogsV1Type:   "OpenTelemetryLogsV1Type",
+	BrowserPerfDataType:       "BrowserPerfDataType",
+	BrowserErrorLogType:       "BrowserErrorLogType",
+	ZabbixRequestType:         "ZabbixRequestType",
+}
+
+// NewExtensionEvent builds an event of the extension type with the serialized message.
+func NewExtensionEvent(name string, t v1.SniffType, data []byte) *v1.SniffData {
+	return &v1.SniffData{
+		Name:      name,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Meta:      nil,
+		Type:      t,
+		Remote:    true,
+		Data: &v1.SniffData_Segment{
+			Segment: data,
+		},
+	}
+}
+
+// ExtensionData returns the serialized message of the extension type event.
+func ExtensionData(e *v1.SniffData) []byte {
+	return e.GetSegment()
+}
+
+// TypeName returns the readable name of both the declared types and the extension types.
+func TypeName(t v1.SniffType) string {
+	if name, ok := extensionTypeNames[t]; ok {
+		return name
+	}
+	return t.String()
+}
+
+// ParseTypeName returns the type of the readable name, which could be both the declared types and the extension types.
+func ParseTypeName(name string) (v1.SniffType, bool) {
+	if t, ok := v1.SniffType_value[name]; ok {
+		return v1.SniffType(t), true
+	}
+	for t, n := range extensionTypeNames {
+		if n == name && t != AnyType {
+			return t, true
+		}
+	}
+	return 0, false
+}
@@ -27,9 +27,21 @@ type SenderConfig struct {
 	// plugins config
 	ForwardersConfig []plugin.Config `mapstructure:"forwarders"`  // forwarder plugins config
 	FallbackerConfig plugin.Config   `mapstructure:"fallbacker"`  // fallbacker plugins config
-	ClientName       string          `mapstructure:"client_name"` // client plugin name
+	ClientName       string          `mapstructure:"client_name"` // client plugin name, optional when the forwarders do not need a client
 
-	MaxBufferSize  int `mapstructure:"max_buffer_size"`  // the max buffer capacity
-	MinFlushEvents int `mapstructure:"min_flush_events"` // the min flush events when receives a timer flush signal
-	FlushTime      int `mapstructure:"flush_time"`       // the period flush time
+	MaxBufferSize      int `mapstructure:"max_buffer_size"`      // the max buffer capacity
+	MinFlushEvents     int `mapstructure:"min_flush_events"`     // the min flush events when receives a timer flush signal
+	FlushTime          int `mapstructure:"flush_time"`           // the period flush time
+	MaxInflightBatches int `mapstructure:"max_inflight_batches"` // the max batches forwarded concurrently in a partition, acked in order
+
+	Mirrors []*MirrorConfig `mapstructure:"mirrors"` // the mirrors receive a copy of the forwarded events, optional
+}
+
+// MirrorConfig sends a copy of the events to another client, the failures and the blocking of the mirror never affect the sender.
+type MirrorConfig struct {
+	ForwardersConfig []plugin.Config `mapstructure:"forwarders"`  // forwarder plugins config
+	FallbackerConfig plugin.Config   `mapstructure:"fallbacker"`  // fallbacker plugins config
+	ClientName       string          `mapstructure:"client_name"` // client plugin name, optional when the forwarders do not need a client
+
+	MaxPendingBatches int `mapstructure:"max_pending_batches"` // the max batches waiting to be mirrored, the new batches are dropped when full
 }
@@ -31,11 +31,16 @@ func NewSender(cfg *api.SenderConfig) api.Sender {
 		config:            cfg,
 		runningForwarders: []forwarder.Forwarder{},
 		runningFallbacker: fallbacker.GetFallbacker(cfg.FallbackerConfig),
-		runningClient:     sharing.Manager[cfg.ClientName].(client.Client),
 		listener:          make(chan client.ClientStatus),
 	}
+	if cfg.ClientName != "" {
+		s.runningClient = sharing.Manager[cfg.ClientName].(client.Client)
+	}
 	for _, c := range s.config.ForwardersConfig {
 		s.runningForwarders = append(s.runningForwarders, forwarder.GetForwarder(c))
 	}
+	for _, c := range s.config.Mirrors {
+		s.mirrors = append(s.mirrors, newMirror(cfg.PipeName, c))
+	}
 	return s
 }
@@ -0,0 +1,63 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sender
+
+import "sync"
+
+// inflight limits the in-flight batches of a partition, and acknowledges them in the flushed order,
+// so the acknowledged offset of the partition always advances monotonically.
+type inflight struct {
+	slots chan struct{}
+	lock  sync.Mutex
+	tail  chan struct{} // closed when the last submitted batch is acknowledged
+}
+
+func newInflight(maxBatches int) *inflight {
+	return &inflight{slots: make(chan struct{}, maxBatches)}
+}
+
+// submit forwards the batch by a new routine when a slot is free, and acknowledges it after the previous batches.
+func (i *inflight) submit(forward, ack func()) {
+	i.slots <- struct{}{}
+	acked := make(chan struct{})
+	i.lock.Lock()
+	previous := i.tail
+	i.tail = acked
+	i.lock.Unlock()
+	go func() {
+		defer func() {
+			<-i.slots
+		}()
+		forward()
+		if previous != nil {
+			<-previous
+		}
+		ack()
+		close(acked)
+	}()
+}
+
+// wait blocks until the submitted batches are acknowledged.
+func (i *inflight) wait() {
+	i.lock.Lock()
+	tail := i.tail
+	i.lock.Unlock()
+	if tail != nil {
+		<-tail
+	}
+}
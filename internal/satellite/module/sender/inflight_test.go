@@ -0,0 +1,64 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sender
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestInflightAckInOrder(t *testing.T) {
+	i := newInflight(3)
+	var lock sync.Mutex
+	var acked []int
+	var running, maxRunning int32
+	for batch := 0; batch < 9; batch++ {
+		b := batch
+		i.submit(func() {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				previous := atomic.LoadInt32(&maxRunning)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+					break
+				}
+			}
+			// the earlier batches finish later.
+			time.Sleep(time.Duration(3-b%3) * 10 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}, func() {
+			lock.Lock()
+			defer lock.Unlock()
+			acked = append(acked, b)
+		})
+	}
+	i.wait()
+
+	if len(acked) != 9 {
+		t.Fatalf("expected 9 acknowledged batches, but got %d", len(acked))
+	}
+	for inx, b := range acked {
+		if inx != b {
+			t.Fatalf("the batches are not acknowledged in order: %v", acked)
+		}
+	}
+	if m := atomic.LoadInt32(&maxRunning); m < 2 || m > 3 {
+		t.Fatalf("expected 2 or 3 concurrent batches, but got %d", m)
+	}
+}
@@ -0,0 +1,218 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sender
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	"github.com/apache/skywalking-satellite/internal/satellite/module/sender/api"
+	"github.com/apache/skywalking-satellite/internal/satellite/sharing"
+	"github.com/apache/skywalking-satellite/internal/satellite/telemetry"
+	client "github.com/apache/skywalking-satellite/plugins/client/api"
+	fallbacker "github.com/apache/skywalking-satellite/plugins/fallbacker/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+)
+
+var defaultMirrorMaxPendingBatches = 100
+
+// mirror forwards a copy of the events of the sender to another client by its own routine,
+// so a slow or disconnected mirror only drops its own pending batches.
+type mirror struct {
+	pipe   string
+	config *api.MirrorConfig
+
+	// dependency plugins
+	runningForwarders []forwarder.Forwarder
+	runningFallbacker fallbacker.Fallbacker
+	runningClient     client.Client
+
+	// self components
+	pending  chan map[v1.SniffType]event.BatchEvents // the batches waiting to be mirrored
+	listener chan client.ClientStatus                // client status listener
+	blocking int32                                   // the status of the client
+	stop     chan struct{}
+	stopOnce sync.Once
+
+	// metrics
+	sendCounter telemetry.Counter
+}
+
+func newMirror(pipe string, cfg *api.MirrorConfig) *mirror {
+	m := &mirror{
+		pipe:              pipe,
+		config:            cfg,
+		runningForwarders: []forwarder.Forwarder{},
+		runningFallbacker: fallbacker.GetFallbacker(cfg.FallbackerConfig),
+		listener:          make(chan client.ClientStatus),
+		stop:              make(chan struct{}),
+	}
+	if cfg.ClientName != "" {
+		m.runningClient = sharing.Manager[cfg.ClientName].(client.Client)
+	}
+	for _, c := range cfg.ForwardersConfig {
+		m.runningForwarders = append(m.runningForwarders, forwarder.GetForwarder(c))
+	}
+	return m
+}
+
+func (m *mirror) prepare() error {
+	var connection interface{}
+	if m.runningClient != nil {
+		m.runningClient.RegisterListener(m.listener)
+		connection = m.runningClient.GetConnectedClient()
+	}
+	for _, runningForwarder := range m.runningForwarders {
+		if err := runningForwarder.Prepare(connection); err != nil {
+			return err
+		}
+		// the mirror forwards the batches one by one.
+		if forwarder.RequiresConcurrentBatches(runningForwarder) {
+			return fmt.Errorf("the %s could not be used in the mirror as it requires forwarding the batches concurrently", runningForwarder.Name())
+		}
+	}
+	maxPendingBatches := m.config.MaxPendingBatches
+	if maxPendingBatches <= 0 {
+		maxPendingBatches = defaultMirrorMaxPendingBatches
+	}
+	m.pending = make(chan map[v1.SniffType]event.BatchEvents, maxPendingBatches)
+	m.sendCounter = telemetry.NewCounter("sender_mirror_output_count", "Total number of the output count of the mirrors in the Sender.",
+		"pipe", "client", "status", "type")
+	return nil
+}
+
+// enqueue never blocks the sender, the events are dropped when the pending batches are full.
+func (m *mirror) enqueue(events map[v1.SniffType]event.BatchEvents) {
+	select {
+	case m.pending <- events:
+	default:
+		for t, batchEvents := range events {
+			m.sendCounter.Add(float64(len(batchEvents)), m.pipe, m.config.ClientName, "dropped", event.TypeName(t))
+		}
+		log.Logger.WithFields(logrus.Fields{
+			"pipe":   m.pipe,
+			"client": m.config.ClientName,
+		}).Warnf("the pending batches of the mirror are full, drop the batch")
+	}
+}
+
+// listen the client status.
+func (m *mirror) listen(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case status := <-m.listener:
+			switch status {
+			case client.Connected:
+				log.Logger.WithFields(logrus.Fields{"pipe": m.pipe, "client": m.config.ClientName}).
+					Info("the client connection of the mirror connected")
+				atomic.StoreInt32(&m.blocking, 0)
+			case client.Disconnect:
+				log.Logger.WithFields(logrus.Fields{"pipe": m.pipe, "client": m.config.ClientName}).
+					Info("the client connection of the mirror disconnected")
+				atomic.StoreInt32(&m.blocking, 1)
+			}
+		}
+	}
+}
+
+// run keeps forwarding the pending batches until the mirror is closed, the pending batches are kept when disconnecting.
+func (m *mirror) run(wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer log.Logger.WithFields(logrus.Fields{"pipe": m.pipe, "client": m.config.ClientName}).Infof("mirror routine closed")
+	for {
+		if atomic.LoadInt32(&m.blocking) == 1 {
+			select {
+			case <-m.stop:
+				m.drain()
+				return
+			case <-time.After(100 * time.Millisecond):
+				continue
+			}
+		}
+		select {
+		case <-m.stop:
+			m.drain()
+			return
+		case events := <-m.pending:
+			m.forward(events)
+		}
+	}
+}
+
+// drain tries to forward the pending batches in the shutdown hook time, then closes the forwarders.
+func (m *mirror) drain() {
+	defer func() {
+		if err := forwarder.CloseForwarders(m.runningForwarders); err != nil {
+			log.Logger.WithFields(logrus.Fields{"pipe": m.pipe, "client": m.config.ClientName}).
+				Warnf("close the forwarders of the mirror failure: %v", err)
+		}
+	}()
+	deadline := time.After(module.ShutdownHookTime)
+	for {
+		select {
+		case <-deadline:
+			return
+		case events := <-m.pending:
+			m.forward(events)
+		default:
+			return
+		}
+	}
+}
+
+func (m *mirror) forward(events map[v1.SniffType]event.BatchEvents) {
+	for _, f := range m.runningForwarders {
+		for t, batchEvents := range events {
+			if f.ForwardType() != t && f.ForwardType() != event.AnyType {
+				continue
+			}
+			if err := f.Forward(batchEvents); err == nil {
+				m.sendCounter.Add(float64(len(batchEvents)), m.pipe, m.config.ClientName, "success", event.TypeName(t))
+				continue
+			} else {
+				log.Logger.WithFields(logrus.Fields{
+					"pipe":   m.pipe,
+					"client": m.config.ClientName,
+					"size":   len(batchEvents),
+				}).Warnf("mirror event failure: %v", err)
+			}
+			if !m.runningFallbacker.FallBack(batchEvents, f.Forward) {
+				m.sendCounter.Add(float64(len(batchEvents)), m.pipe, m.config.ClientName, "failure", event.TypeName(t))
+			}
+		}
+	}
+}
+
+func (m *mirror) close() {
+	m.stopOnce.Do(func() {
+		close(m.stop)
+	})
+}
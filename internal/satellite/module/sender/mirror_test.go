@@ -0,0 +1,111 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sender
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/internal/satellite/module/sender/api"
+	"github.com/apache/skywalking-satellite/internal/satellite/telemetry"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/telemetry/none"
+	"github.com/apache/skywalking-satellite/plugins/fallbacker/none"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+func init() {
+	log.Init(&log.LoggerConfig{})
+	c := &telemetry.Config{}
+	c.ExportType = "none"
+	if err := telemetry.Init(c); err != nil {
+		panic(err)
+	}
+}
+
+type blockingForwarder struct {
+	forwarder.Forwarder
+	release   chan struct{}
+	lock      sync.Mutex
+	forwarded int
+}
+
+func (f *blockingForwarder) Prepare(_ interface{}) error {
+	return nil
+}
+
+func (f *blockingForwarder) Forward(batch event.BatchEvents) error {
+	<-f.release
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.forwarded += len(batch)
+	return nil
+}
+
+func (f *blockingForwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_Logging
+}
+
+func (f *blockingForwarder) count() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.forwarded
+}
+
+func TestMirrorNeverBlocks(t *testing.T) {
+	f := &blockingForwarder{release: make(chan struct{})}
+	m := &mirror{
+		pipe:              "pipe",
+		config:            &api.MirrorConfig{MaxPendingBatches: 2},
+		runningForwarders: []forwarder.Forwarder{f},
+		runningFallbacker: &none.Fallbacker{},
+		stop:              make(chan struct{}),
+	}
+	if err := m.prepare(); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go m.run(&wg)
+
+	events := map[v1.SniffType]event.BatchEvents{v1.SniffType_Logging: {&v1.SniffData{}}}
+	finished := make(chan struct{})
+	go func() {
+		// the first batch is blocked in the forwarder, the next 2 batches are pending, the others are dropped.
+		for i := 0; i < 10; i++ {
+			m.enqueue(events)
+		}
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatalf("the mirror blocks the enqueue")
+	}
+
+	close(f.release)
+	m.close()
+	wg.Wait()
+	if c := f.count(); c < 2 || c > 3 {
+		t.Fatalf("expected 2 or 3 mirrored events, but got %d", c)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/apache/skywalking-satellite/internal/satellite/module/buffer"
 	gatherer "github.com/apache/skywalking-satellite/internal/satellite/module/gatherer/api"
 	"github.com/apache/skywalking-satellite/internal/satellite/module/sender/api"
+	"github.com/apache/skywalking-satellite/internal/satellite/tap"
 	"github.com/apache/skywalking-satellite/internal/satellite/telemetry"
 	client "github.com/apache/skywalking-satellite/plugins/client/api"
 	fallbacker "github.com/apache/skywalking-satellite/plugins/fallbacker/api"
@@ -53,6 +54,7 @@ type Sender struct {
 	runningForwarders []forwarder.Forwarder
 	runningFallbacker fallbacker.Fallbacker
 	runningClient     client.Client
+	mirrors           []*mirror
 
 	// dependency modules
 	gatherer gatherer.Gatherer
@@ -62,6 +64,7 @@ type Sender struct {
 	listener     chan client.ClientStatus         // client status listener
 	flushChannel []chan *buffer.BatchBuffer       // forwarder flush channel
 	buffers      []*buffer.BatchBuffer            // cache the downstream petitioned input data
+	inflights    []*inflight                      // the in-flight batches of partitions, nil when forwarding one by one
 	blocking     int32                            // the status of input channel
 	shutdownOnce sync.Once
 
@@ -72,12 +75,25 @@ type Sender struct {
 // Prepare register the client status listener to the client manager and open partitioned input channel.
 func (s *Sender) Prepare() error {
 	log.Logger.WithField("pipe", s.config.PipeName).Info("sender module is preparing...")
-	s.runningClient.RegisterListener(s.listener)
+	// the client is optional when the forwarders only write to the local, such as the files.
+	var connection interface{}
+	if s.runningClient != nil {
+		s.runningClient.RegisterListener(s.listener)
+		connection = s.runningClient.GetConnectedClient()
+	}
 	for _, runningForwarder := range s.runningForwarders {
-		err := runningForwarder.Prepare(s.runningClient.GetConnectedClient())
+		err := runningForwarder.Prepare(connection)
 		if err != nil {
 			return err
 		}
+		if s.config.MaxInflightBatches <= 1 && forwarder.RequiresConcurrentBatches(runningForwarder) {
+			return fmt.Errorf("the %s requires the max_inflight_batches of the sender greater than 1", runningForwarder.Name())
+		}
+	}
+	for _, m := range s.mirrors {
+		if err := m.prepare(); err != nil {
+			return err
+		}
 	}
 	s.inputs = make([]chan *event.OutputEventContext, s.gatherer.PartitionCount())
 	s.buffers = make([]*buffer.BatchBuffer, s.gatherer.PartitionCount())
@@ -87,6 +103,12 @@ func (s *Sender) Prepare() error {
 		s.buffers[partition] = buffer.NewBatchBuffer(s.config.MaxBufferSize)
 		s.flushChannel[partition] = make(chan *buffer.BatchBuffer)
 	}
+	if s.config.MaxInflightBatches > 1 {
+		s.inflights = make([]*inflight, s.gatherer.PartitionCount())
+		for partition := range s.inflights {
+			s.inflights[partition] = newInflight(s.config.MaxInflightBatches)
+		}
+	}
 	s.sendCounter = telemetry.NewCounter("sender_output_count", "Total number of the output count in the Sender.", "pipe", "status", "type")
 	return nil
 }
@@ -95,8 +117,12 @@ func (s *Sender) Prepare() error {
 func (s *Sender) Boot(ctx context.Context) {
 	log.Logger.WithField("pipe", s.config.PipeName).Info("sender module is starting...")
 	var wg sync.WaitGroup
-	wg.Add(2*s.gatherer.PartitionCount() + 1)
+	wg.Add(2*s.gatherer.PartitionCount() + 1 + 2*len(s.mirrors))
 	go s.listen(ctx, &wg)
+	for _, m := range s.mirrors {
+		go m.listen(ctx, &wg)
+		go m.run(&wg)
+	}
 	for partition := 0; partition < s.gatherer.PartitionCount(); partition++ {
 		go s.store(ctx, partition, &wg)
 		go s.flush(ctx, partition, &wg)
@@ -178,19 +204,47 @@ func (s *Sender) flush(ctx context.Context, partition int, wg *sync.WaitGroup) {
 			s.Shutdown()
 			return
 		case b := <-s.flushChannel[partition]:
-			s.consume(b)
+			if s.inflights == nil {
+				s.consume(b)
+			} else if b.Len() > 0 {
+				s.inflights[partition].submit(func() {
+					s.forward(b)
+				}, func() {
+					s.gatherer.Ack(b.Last())
+				})
+			}
 		}
 	}
 }
 
-// Shutdown closes the channels and tries to force forward the events in the buffer.
+// Shutdown closes the channels and tries to force forward the events in the buffer, then closes the forwarders and the mirrors.
+// When the buffers are not forwarded in the ShutdownHookTime, the forwarders and the mirrors are closed after they finish.
 func (s *Sender) Shutdown() {
 	s.shutdownOnce.Do(func() {
-		s.shutdown0()
+		finished := s.shutdown0()
+		select {
+		case <-finished:
+			s.closeOutputs()
+		default:
+			go func() {
+				<-finished
+				s.closeOutputs()
+			}()
+		}
 	})
 }
 
-func (s *Sender) shutdown0() {
+func (s *Sender) closeOutputs() {
+	if err := forwarder.CloseForwarders(s.runningForwarders); err != nil {
+		log.Logger.WithField("pipe", s.config.PipeName).Warnf("close the forwarders failure: %v", err)
+	}
+	for _, m := range s.mirrors {
+		m.close()
+	}
+}
+
+// shutdown0 returns the channel closed when the buffers of all partitions are forwarded.
+func (s *Sender) shutdown0() <-chan struct{} {
 	log.Logger.WithField("pipe", s.config.PipeName).Info("sender module is closing")
 	for _, in := range s.inputs {
 		close(in)
@@ -201,6 +255,9 @@ func (s *Sender) shutdown0() {
 	for partition := range s.buffers {
 		go func(p int) {
 			defer wg.Done()
+			if s.inflights != nil {
+				s.inflights[p].wait()
+			}
 			s.consume(s.buffers[p])
 		}(partition)
 	}
@@ -210,15 +267,14 @@ func (s *Sender) shutdown0() {
 	}()
 
 	ticker := time.NewTicker(module.ShutdownHookTime)
+	defer ticker.Stop()
 	select {
 	case <-ticker.C:
-		for _, buffer := range s.buffers {
-			s.consume(buffer)
-		}
-		return
+		// the buffers are still owned by the partitions, so they are left to be forwarded by them.
+		log.Logger.WithField("pipe", s.config.PipeName).Warn("the buffers of the sender module are not forwarded in the shutdown hook time")
 	case <-finished:
-		return
 	}
+	return finished
 }
 
 // consume would forward the events by type and ack this batch.
@@ -226,6 +282,12 @@ func (s *Sender) consume(batch *buffer.BatchBuffer) {
 	if batch.Len() == 0 {
 		return
 	}
+	s.forward(batch)
+	s.gatherer.Ack(batch.Last())
+}
+
+// forward the events of the batch by type.
+func (s *Sender) forward(batch *buffer.BatchBuffer) {
 	log.Logger.WithFields(logrus.Fields{
 		"pipe":   s.config.PipeName,
 		"offset": batch.Last(),
@@ -240,13 +302,19 @@ func (s *Sender) consume(batch *buffer.BatchBuffer) {
 			}
 		}
 	}
+	for _, batchEvents := range events {
+		tap.Mirror(s.config.PipeName, batchEvents)
+	}
+	for _, m := range s.mirrors {
+		m.enqueue(events)
+	}
 	for _, f := range s.runningForwarders {
 		for t, batchEvents := range events {
-			if f.ForwardType() != t {
+			if f.ForwardType() != t && f.ForwardType() != event.AnyType {
 				continue
 			}
 			if err := f.Forward(batchEvents); err == nil {
-				s.sendCounter.Add(float64(len(batchEvents)), s.config.PipeName, "success", f.ForwardType().String())
+				s.sendCounter.Add(float64(len(batchEvents)), s.config.PipeName, "success", event.TypeName(t))
 				continue
 			} else {
 				log.Logger.WithFields(logrus.Fields{
@@ -256,11 +324,10 @@ func (s *Sender) consume(batch *buffer.BatchBuffer) {
 				}).Warnf("forward event failure: %v", err)
 			}
 			if !s.runningFallbacker.FallBack(batchEvents, f.Forward) {
-				s.sendCounter.Add(float64(len(batchEvents)), s.config.PipeName, "failure", f.ForwardType().String())
+				s.sendCounter.Add(float64(len(batchEvents)), s.config.PipeName, "failure", event.TypeName(t))
 			}
 		}
 	}
-	s.gatherer.Ack(batch.Last())
 }
 
 func (s *Sender) InputDataChannel(partition int) chan<- *event.OutputEventContext {
@@ -0,0 +1,126 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replay
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// filter decides which events are replayed, the empty conditions match all events.
+type filter struct {
+	types    map[v1.SniffType]bool
+	services map[string]bool
+	start    time.Time
+	end      time.Time
+}
+
+// apply returns the event to replay, or nil when the event does not match.
+// The batch events only keep the matched elements, and the events without the service
+// are not matched when filtering by the services.
+func (f *filter) apply(e *v1.SniffData) *v1.SniffData {
+	if len(f.types) > 0 && !f.types[e.GetType()] {
+		return nil
+	}
+	receiveTime := time.UnixMilli(e.GetTimestamp())
+	if (!f.start.IsZero() && receiveTime.Before(f.start)) || (!f.end.IsZero() && !receiveTime.Before(f.end)) {
+		return nil
+	}
+	if len(f.services) == 0 {
+		return e
+	}
+	switch data := e.GetData().(type) {
+	case *v1.SniffData_LogList:
+		logs := make([][]byte, 0, len(data.LogList.GetLogs()))
+		for _, l := range data.LogList.GetLogs() {
+			logData := &logging.LogData{}
+			if err := proto.Unmarshal(l, logData); err != nil {
+				log.Logger.Warnf("unmarshal the native log failure: %v", err)
+				continue
+			}
+			if f.services[logData.GetService()] {
+				logs = append(logs, l)
+			}
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		result := proto.Clone(e).(*v1.SniffData)
+		result.Data = &v1.SniffData_LogList{LogList: &v1.BatchLogList{Logs: logs}}
+		return result
+	case *v1.SniffData_MeterCollection:
+		meters := make([]*agent.MeterData, 0, len(data.MeterCollection.GetMeterData()))
+		for _, m := range data.MeterCollection.GetMeterData() {
+			if f.services[m.GetService()] {
+				meters = append(meters, m)
+			}
+		}
+		if len(meters) == 0 {
+			return nil
+		}
+		result := proto.Clone(e).(*v1.SniffData)
+		result.Data = &v1.SniffData_MeterCollection{MeterCollection: &agent.MeterDataCollection{MeterData: meters}}
+		return result
+	case *v1.SniffData_Segment:
+		if e.GetType() != v1.SniffType_TracingType {
+			return nil
+		}
+		segment := &agent.SegmentObject{}
+		if err := proto.Unmarshal(data.Segment, segment); err != nil {
+			log.Logger.Warnf("unmarshal the segment failure: %v", err)
+			return nil
+		}
+		return f.matchService(e, segment.GetService())
+	default:
+		return f.matchService(e, serviceOf(e))
+	}
+}
+
+func (f *filter) matchService(e *v1.SniffData, service string) *v1.SniffData {
+	if service == "" || !f.services[service] {
+		return nil
+	}
+	return e
+}
+
+// serviceOf returns the service of the event which only belongs to one service, return empty when it is unknown.
+func serviceOf(e *v1.SniffData) string {
+	switch {
+	case e.GetMeter() != nil:
+		return e.GetMeter().GetService()
+	case e.GetJvm() != nil:
+		return e.GetJvm().GetService()
+	case e.GetClr() != nil:
+		return e.GetClr().GetService()
+	case e.GetInstance() != nil:
+		return e.GetInstance().GetService()
+	case e.GetInstancePing() != nil:
+		return e.GetInstancePing().GetService()
+	case e.GetEvent() != nil:
+		return e.GetEvent().GetSource().GetService()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,255 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replay
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins"
+	client "github.com/apache/skywalking-satellite/plugins/client/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+
+	// import the default telemetry implement
+	_ "github.com/apache/skywalking-satellite/internal/satellite/telemetry/none"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// Options defines what and how to replay.
+type Options struct {
+	Pipe      string    // The pipe whose client and forwarders are used to replay, could be empty when there is only one pipe.
+	Files     []string  // The archive files or directories.
+	FromQueue bool      // Replay the events in the queue of the pipe.
+	Types     []string  // The names of the replayed types, all types are replayed when it is empty.
+	Services  []string  // The replayed services, all services are replayed when it is empty.
+	Start     time.Time // The events received before it are skipped.
+	End       time.Time // The events received at or after it are skipped.
+	Rate      float64   // The max events per second, 0 means no limit.
+	BatchSize int       // The max events count of a batch.
+	Retries   int       // The retry times of a failed batch.
+}
+
+type replayer struct {
+	options    *Options
+	source     source
+	filter     *filter
+	forwarders []forwarder.Forwarder
+}
+
+// Start replays the events through the client and forwarders of the pipe.
+func Start(cfg *config.SatelliteConfig, options *Options) error {
+	log.Init(cfg.Logger)
+	plugins.RegisterPlugins()
+	return start(cfg, options)
+}
+
+func start(cfg *config.SatelliteConfig, options *Options) error {
+	if len(options.Files) == 0 && !options.FromQueue {
+		return fmt.Errorf("the archive files or the queue is required to replay")
+	}
+	if options.BatchSize <= 0 {
+		return fmt.Errorf("the batch size must be positive")
+	}
+	pipe, err := findPipe(cfg, options.Pipe)
+	if err != nil {
+		return err
+	}
+	f, err := newFilter(options)
+	if err != nil {
+		return err
+	}
+	c, err := startClient(cfg, pipe.Sender.ClientName)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		defer c.Close()
+	}
+	r := &replayer{options: options, filter: f}
+	defer func() {
+		if err := forwarder.CloseForwarders(r.forwarders); err != nil {
+			log.Logger.Warnf("close the forwarders failure: %v", err)
+		}
+	}()
+	for _, forwarderConfig := range pipe.Sender.ForwardersConfig {
+		fw := forwarder.GetForwarder(forwarderConfig)
+		// the forwarders accepting all types, such as the archive-file-forwarder, would write the replayed data again.
+		if fw.ForwardType() == event.AnyType {
+			log.Logger.Infof("the %s is skipped in replaying", fw.Name())
+			continue
+		}
+		var connection interface{}
+		if c != nil {
+			connection = c.GetConnectedClient()
+		}
+		if err := fw.Prepare(connection); err != nil {
+			return err
+		}
+		// the batches are replayed one by one.
+		if forwarder.RequiresConcurrentBatches(fw) {
+			return fmt.Errorf("the %s could not be used in replaying as it requires forwarding the batches concurrently", fw.Name())
+		}
+		r.forwarders = append(r.forwarders, fw)
+	}
+	if options.FromQueue {
+		r.source, err = newQueueSource(pipe.Gatherer.QueueConfig)
+	} else {
+		r.source, err = newArchiveSource(options.Files)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.source.close(); err != nil {
+			log.Logger.Warnf("close the replay source failure: %v", err)
+		}
+	}()
+	return r.replay()
+}
+
+func findPipe(cfg *config.SatelliteConfig, name string) (*config.PipeConfig, error) {
+	if name == "" && len(cfg.Pipes) == 1 {
+		return cfg.Pipes[0], nil
+	}
+	for _, pipe := range cfg.Pipes {
+		if pipe.PipeCommonConfig != nil && pipe.PipeCommonConfig.PipeName == name {
+			return pipe, nil
+		}
+	}
+	return nil, fmt.Errorf("could not find the pipe: %s", name)
+}
+
+func newFilter(options *Options) (*filter, error) {
+	f := &filter{start: options.Start, end: options.End}
+	if len(options.Types) > 0 {
+		f.types = make(map[v1.SniffType]bool)
+		for _, name := range options.Types {
+			t, ok := event.ParseTypeName(name)
+			if !ok {
+				return nil, fmt.Errorf("unknown type: %s", name)
+			}
+			f.types[t] = true
+		}
+	}
+	if len(options.Services) > 0 {
+		f.services = make(map[string]bool)
+		for _, s := range options.Services {
+			f.services[s] = true
+		}
+	}
+	return f, nil
+}
+
+// startClient only starts the client used by the pipe, the servers are not started in replaying.
+func startClient(cfg *config.SatelliteConfig, name string) (client.Client, error) {
+	if name == "" {
+		return nil, nil
+	}
+	for _, clientConfig := range cfg.Sharing.Clients {
+		c := client.GetClient(clientConfig)
+		if c.Name() != name {
+			continue
+		}
+		if err := c.Prepare(); err != nil {
+			return nil, err
+		}
+		if err := c.Start(); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("could not find the client: %s", name)
+}
+
+func (r *replayer) replay() error {
+	startTime := time.Now()
+	var total, batchCount int
+	batch := make(map[v1.SniffType]event.BatchEvents)
+	for {
+		e, err := r.source.next()
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if err == nil {
+			replayed := r.filter.apply(e)
+			// the dropped or partially replayed events are kept in the source.
+			if replayed != e {
+				r.source.skip()
+			}
+			if replayed != nil {
+				batch[replayed.GetType()] = append(batch[replayed.GetType()], replayed)
+				batchCount++
+			}
+		}
+		if batchCount < r.options.BatchSize && err == nil {
+			continue
+		}
+		if err := r.forward(batch); err != nil {
+			return err
+		}
+		r.source.commit()
+		total += batchCount
+		batchCount = 0
+		batch = make(map[v1.SniffType]event.BatchEvents)
+		if err == io.EOF {
+			log.Logger.Infof("replayed %d events in %s", total, time.Since(startTime))
+			return nil
+		}
+		r.throttle(startTime, total)
+	}
+}
+
+func (r *replayer) forward(batch map[v1.SniffType]event.BatchEvents) error {
+	for t, events := range batch {
+		for _, f := range r.forwarders {
+			if f.ForwardType() != t && f.ForwardType() != event.AnyType {
+				continue
+			}
+			var err error
+			for i := 0; i <= r.options.Retries; i++ {
+				if i > 0 {
+					time.Sleep(time.Duration(i) * time.Second)
+				}
+				if err = f.Forward(events); err == nil {
+					break
+				}
+				log.Logger.Warnf("%s forward %d %s events failure: %v", f.Name(), len(events), event.TypeName(t), err)
+			}
+			if err != nil {
+				return fmt.Errorf("replay is stopped by the failure of the %s: %v", f.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// throttle sleeps to keep the replay rate under the limit.
+func (r *replayer) throttle(startTime time.Time, total int) {
+	if r.options.Rate <= 0 {
+		return
+	}
+	expected := time.Duration(float64(total) / r.options.Rate * float64(time.Second))
+	if elapsed := time.Since(startTime); elapsed < expected {
+		time.Sleep(expected - elapsed)
+	}
+}
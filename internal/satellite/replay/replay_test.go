@@ -0,0 +1,189 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replay
+
+import (
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pkgconfig "github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	sender "github.com/apache/skywalking-satellite/internal/satellite/module/sender/api"
+	"github.com/apache/skywalking-satellite/plugins"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/file/archive"
+	"github.com/apache/skywalking-satellite/plugins/queue/memory"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// recordForwarder records the forwarded events in the tests.
+type recordForwarder struct {
+	pkgconfig.CommonFields
+	Type string `mapstructure:"type"`
+}
+
+var recorded []*v1.SniffData
+
+func (f *recordForwarder) Name() string                { return "record-forwarder" }
+func (f *recordForwarder) ShowName() string            { return "Record Forwarder" }
+func (f *recordForwarder) Description() string         { return "" }
+func (f *recordForwarder) DefaultConfig() string       { return "" }
+func (f *recordForwarder) Prepare(_ interface{}) error { return nil }
+func (f *recordForwarder) SupportedSyncInvoke() bool   { return false }
+func (f *recordForwarder) ForwardType() v1.SniffType {
+	t, _ := event.ParseTypeName(f.Type)
+	return t
+}
+
+func (f *recordForwarder) Forward(b event.BatchEvents) error {
+	recorded = append(recorded, b...)
+	return nil
+}
+
+func (f *recordForwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, nil
+}
+
+func TestReplayArchive(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	plugins.RegisterPlugins()
+	plugin.RegisterPlugin(&recordForwarder{})
+	recorded = nil
+	sourceDir, targetDir := t.TempDir(), t.TempDir()
+	writeArchive(t, sourceDir, []*v1.SniffData{
+		{Timestamp: 1000, Type: v1.SniffType_Logging, Data: &v1.SniffData_LogList{LogList: &v1.BatchLogList{
+			Logs: [][]byte{marshal(t, &logging.LogData{Service: "a"}), marshal(t, &logging.LogData{Service: "b"})},
+		}}},
+		{Timestamp: 2000, Type: v1.SniffType_MeterType, Data: &v1.SniffData_MeterCollection{MeterCollection: &agent.MeterDataCollection{
+			MeterData: []*agent.MeterData{{Service: "a"}, {Service: "b"}},
+		}}},
+		{Timestamp: 3000, Type: v1.SniffType_JVMMetricType, Data: &v1.SniffData_Jvm{Jvm: &agent.JVMMetricCollection{Service: "b"}}},
+		event.NewExtensionEvent("zipkin", event.ZipkinSpansType, []byte("spans")),
+		{Timestamp: 9000, Type: v1.SniffType_Logging, Data: &v1.SniffData_LogList{LogList: &v1.BatchLogList{
+			Logs: [][]byte{marshal(t, &logging.LogData{Service: "a"})},
+		}}},
+	})
+
+	cfg := config.NewDefaultSatelliteConfig()
+	cfg.Pipes = []*config.PipeConfig{{
+		Sender: &sender.SenderConfig{
+			ForwardersConfig: []plugin.Config{
+				{plugin.NameField: "record-forwarder", "type": "Logging"},
+				{plugin.NameField: "record-forwarder", "type": "MeterType"},
+				// the forwarders accepting all types are skipped.
+				{
+					plugin.NameField: archive.Name,
+					"directory":      targetDir,
+					"format":         "protobuf",
+					"compression":    "none",
+				},
+			},
+		},
+	}}
+	err := start(cfg, &Options{
+		Files:     []string{sourceDir},
+		Services:  []string{"a"},
+		End:       time.UnixMilli(5000),
+		BatchSize: 1,
+		Rate:      1000,
+	})
+	if err != nil {
+		t.Fatalf("replay failure: %v", err)
+	}
+
+	if len(recorded) != 2 {
+		t.Fatalf("expected 2 replayed events, but got %d", len(recorded))
+	}
+	logs := recorded[0].GetLogList().GetLogs()
+	logData := &logging.LogData{}
+	if len(logs) != 1 || proto.Unmarshal(logs[0], logData) != nil || logData.GetService() != "a" {
+		t.Fatalf("expected only the log of the service a, but got %v", recorded[0])
+	}
+	if meters := recorded[1].GetMeterCollection().GetMeterData(); len(meters) != 1 || meters[0].GetService() != "a" {
+		t.Fatalf("expected only the meter of the service a, but got %v", recorded[1])
+	}
+	if entries, _ := os.ReadDir(targetDir); len(entries) != 0 {
+		t.Fatalf("the replayed events should not be archived again")
+	}
+}
+
+func TestQueueSourceSkip(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	plugins.RegisterPlugins()
+	source, err := newQueueSource(plugin.Config{plugin.NameField: memory.Name, "event_buffer_size": 10, "partition": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer source.close()
+	for i := 0; i < 3; i++ {
+		if err := source.queue.Enqueue(&v1.SniffData{Type: v1.SniffType_Logging}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := source.next(); err != nil {
+		t.Fatal(err)
+	}
+	replayed := source.offsets[0]
+	if _, err := source.next(); err != nil {
+		t.Fatal(err)
+	}
+	source.skip()
+	// the events after the skipped event are kept in the blocked partition.
+	if _, err := source.next(); err != io.EOF {
+		t.Fatalf("expected no more events after the skipped event, but got %v", err)
+	}
+	// the offset stops before the skipped event.
+	if source.offsets[0] != replayed {
+		t.Fatalf("the offset after the skipped event should not be acked")
+	}
+}
+
+func writeArchive(t *testing.T, dir string, events []*v1.SniffData) {
+	f := &archive.Forwarder{
+		Directory:        dir,
+		FilePrefix:       "source",
+		Format:           "json",
+		Compression:      "gzip",
+		MaxFileSize:      1,
+		RotationInterval: 60,
+	}
+	if err := f.Prepare(nil); err != nil {
+		t.Fatalf("prepare the archive forwarder failure: %v", err)
+	}
+	if err := f.Forward(events); err != nil {
+		t.Fatalf("write the archive failure: %v", err)
+	}
+}
+
+func marshal(t *testing.T, m proto.Message) []byte {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal failure: %v", err)
+	}
+	return data
+}
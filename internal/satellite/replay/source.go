@@ -0,0 +1,307 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replay
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/queue/api"
+	"github.com/apache/skywalking-satellite/plugins/queue/partition"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// The max size of an archived event.
+const maxEventSize = 64 * 1024 * 1024
+
+// source provides the events to replay.
+type source interface {
+	// next returns the next event, io.EOF would be returned when there are no more events.
+	next() (*v1.SniffData, error)
+	// skip marks the last returned event is not replayed, such as it is dropped by the filter.
+	skip()
+	// commit marks the returned events are replayed, except the skipped events.
+	commit()
+	close() error
+}
+
+// archiveSource reads the events from the files written by the archive-file-forwarder.
+type archiveSource struct {
+	files   []string
+	file    *os.File
+	reader  *bufio.Reader
+	closer  io.Closer
+	isJSON  bool
+	current string
+}
+
+func newArchiveSource(paths []string) (*archiveSource, error) {
+	files := make([]string, 0)
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		entries, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if !entry.IsDir() && isArchiveFile(entry.Name()) {
+				names = append(names, filepath.Join(p, entry.Name()))
+			}
+		}
+		// the file names start with the creation time, so the events are replayed in order.
+		sort.Strings(names)
+		files = append(files, names...)
+	}
+	for _, f := range files {
+		if !isArchiveFile(f) {
+			return nil, fmt.Errorf("unknown archive file format: %s", f)
+		}
+	}
+	return &archiveSource{files: files}, nil
+}
+
+func isArchiveFile(name string) bool {
+	name = strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ".zst")
+	return strings.HasSuffix(name, ".jsonl") || strings.HasSuffix(name, ".pb")
+}
+
+func (s *archiveSource) next() (*v1.SniffData, error) {
+	for {
+		if s.reader == nil {
+			if len(s.files) == 0 {
+				return nil, io.EOF
+			}
+			if err := s.open(s.files[0]); err != nil {
+				return nil, err
+			}
+			s.files = s.files[1:]
+		}
+		e, err := s.read()
+		if err == io.EOF {
+			if err := s.closeFile(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read the archive file %s failure: %v", s.current, err)
+		}
+		return e, nil
+	}
+}
+
+func (s *archiveSource) open(name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	s.file, s.current = file, name
+	var reader io.Reader = file
+	switch {
+	case strings.HasSuffix(name, ".gz"):
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		reader, s.closer = gzipReader, gzipReader
+		name = strings.TrimSuffix(name, ".gz")
+	case strings.HasSuffix(name, ".zst"):
+		decoder, err := zstd.NewReader(file)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		reader, s.closer = decoder, decoder.IOReadCloser()
+		name = strings.TrimSuffix(name, ".zst")
+	}
+	s.isJSON = strings.HasSuffix(name, ".jsonl")
+	s.reader = bufio.NewReader(reader)
+	return nil
+}
+
+func (s *archiveSource) read() (*v1.SniffData, error) {
+	var data []byte
+	if s.isJSON {
+		for len(data) == 0 {
+			line, err := s.reader.ReadBytes('\n')
+			if err != nil && (err != io.EOF || len(line) == 0) {
+				return nil, err
+			}
+			data = []byte(strings.TrimSpace(string(line)))
+		}
+		e := &v1.SniffData{}
+		if err := protojson.Unmarshal(data, e); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+	size, err := readVarint(s.reader)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxEventSize {
+		return nil, fmt.Errorf("the event size %d exceeds the limit", size)
+	}
+	data = make([]byte, size)
+	if _, err := io.ReadFull(s.reader, data); err != nil {
+		return nil, err
+	}
+	e := &v1.SniffData{}
+	if err := proto.Unmarshal(data, e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// readVarint reads the length prefix, io.EOF would be returned only when there are no more bytes.
+func readVarint(reader *bufio.Reader) (uint64, error) {
+	buf := make([]byte, 0, binary.MaxVarintLen64)
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF && len(buf) > 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		buf = append(buf, b)
+		if b < 0x80 {
+			break
+		}
+		if len(buf) == binary.MaxVarintLen64 {
+			return 0, errors.New("illegal length prefix")
+		}
+	}
+	v, n := protowire.ConsumeVarint(buf)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	return v, nil
+}
+
+func (s *archiveSource) closeFile() error {
+	if s.closer != nil {
+		_ = s.closer.Close()
+	}
+	err := s.file.Close()
+	s.file, s.reader, s.closer = nil, nil, nil
+	return err
+}
+
+func (s *archiveSource) skip() {
+}
+
+func (s *archiveSource) commit() {
+}
+
+func (s *archiveSource) close() error {
+	if s.reader == nil {
+		return nil
+	}
+	return s.closeFile()
+}
+
+// queueSource drains the events from the queue of the pipe, the events are acked after replayed.
+// As the ack of the queue commits all the events before the offset, the acked offset of a partition stops
+// before the first skipped event, so the skipped events are kept in the queue.
+type queueSource struct {
+	queue     *partition.PartitionedQueue
+	partition int
+	offsets   map[int]*event.Offset // the offsets to ack
+	previous  *event.Offset         // the offset to ack before the last returned event
+	blocked   map[int]bool          // the partitions stopped at the skipped events
+}
+
+func newQueueSource(queueConfig plugin.Config) (*queueSource, error) {
+	queue := partition.NewPartitionQueue(queueConfig)
+	if err := queue.Initialize(); err != nil {
+		return nil, err
+	}
+	return &queueSource{queue: queue, offsets: make(map[int]*event.Offset), blocked: make(map[int]bool)}, nil
+}
+
+func (s *queueSource) next() (*v1.SniffData, error) {
+	for s.partition < s.queue.TotalPartitionCount() {
+		e, err := s.queue.Dequeue(s.partition)
+		if err == api.ErrEmpty {
+			s.partition++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		offset := e.Offset
+		s.previous = s.offsets[offset.Partition]
+		s.offsets[offset.Partition] = &offset
+		return e.Event, nil
+	}
+	return nil, io.EOF
+}
+
+// skip blocks the current partition, its following events are kept in the queue without being replayed,
+// as they couldn't be acknowledged before the skipped event.
+func (s *queueSource) skip() {
+	s.blocked[s.partition] = true
+	if s.previous == nil {
+		delete(s.offsets, s.partition)
+	} else {
+		s.offsets[s.partition] = s.previous
+	}
+	s.partition++
+}
+
+func (s *queueSource) commit() {
+	for p, offset := range s.offsets {
+		s.queue.Ack(offset)
+		delete(s.offsets, p)
+	}
+}
+
+func (s *queueSource) close() error {
+	for p := range s.blocked {
+		log.Logger.Warnf("the events from the first one not matching the filter are kept in the partition %d of the queue, "+
+			"and they would be sent by the pipe", p)
+	}
+	return s.queue.Close()
+}
@@ -0,0 +1,100 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	otlplogs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+	otlptrace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	zipkin "github.com/apache/skywalking-satellite/protocol/gen-codes/zipkin/proto3"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// extensionMessages creates the messages carried by the extension types.
+var extensionMessages = map[v1.SniffType]func() proto.Message{
+	v1.SniffType_TracingType:        func() proto.Message { return &agent.SegmentObject{} },
+	event.ZipkinSpansType:           func() proto.Message { return &zipkin.ListOfSpans{} },
+	event.OpenTelemetryTracesV1Type: func() proto.Message { return &otlptrace.ExportTraceServiceRequest{} },
+	event.OpenTelemetryLogsV1Type:   func() proto.Message { return &otlplogs.ExportLogsServiceRequest{} },
+	event.BrowserPerfDataType:       func() proto.Message { return &agent.BrowserPerfData{} },
+	event.BrowserErrorLogType:       func() proto.Message { return &agent.BrowserErrorLog{} },
+}
+
+// decodeData decodes the data of the event to JSON. The serialized messages, such as the segments,
+// the logs and the messages of the extension types, are decoded to their protobuf JSON mapping,
+// and the data failed to decode keeps the protobuf JSON mapping of the bytes(base64).
+func decodeData(e *v1.SniffData) (json.RawMessage, error) {
+	switch data := e.GetData().(type) {
+	case *v1.SniffData_Segment:
+		if e.GetType() == event.ZabbixRequestType && json.Valid(data.Segment) {
+			return data.Segment, nil
+		}
+		if create, ok := extensionMessages[e.GetType()]; ok {
+			if msg, err := unmarshal(data.Segment, create()); err == nil {
+				return msg, nil
+			}
+		}
+	case *v1.SniffData_SpanAttachedEvent:
+		if msg, err := unmarshal(data.SpanAttachedEvent, &agent.SpanAttachedEvent{}); err == nil {
+			return msg, nil
+		}
+	case *v1.SniffData_LogList:
+		logs := make([]json.RawMessage, 0, len(data.LogList.GetLogs()))
+		for _, l := range data.LogList.GetLogs() {
+			msg, err := unmarshal(l, &logging.LogData{})
+			if err != nil {
+				break
+			}
+			logs = append(logs, msg)
+		}
+		if len(logs) == len(data.LogList.GetLogs()) {
+			return json.Marshal(logs)
+		}
+	}
+	return marshalOneof(e)
+}
+
+func unmarshal(data []byte, msg proto.Message) (json.RawMessage, error) {
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+	return protojson.Marshal(msg)
+}
+
+// marshalOneof marshals the value of the data oneof field of the event.
+func marshalOneof(e *v1.SniffData) (json.RawMessage, error) {
+	m := e.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("data"))
+	if fd == nil {
+		return json.RawMessage("null"), nil
+	}
+	value := m.Get(fd)
+	if fd.Kind() == protoreflect.MessageKind {
+		return protojson.Marshal(value.Message().Interface())
+	}
+	return json.Marshal(value.Interface())
+}
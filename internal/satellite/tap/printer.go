@@ -0,0 +1,221 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// OutputStdout is the output name of the standard output.
+const OutputStdout = "stdout"
+
+// PrinterConfig is the config of the Printer.
+type PrinterConfig struct {
+	// The ratio of the printed events, from 0(exclusive) to 1.
+	SamplingRate float64 `json:"sampling_rate"`
+	// The dotted paths of the printed fields, such as "data.service", all fields are printed when it is empty.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Printer prints the events as the JSON lines, the opaque bytes are decoded to the protobuf JSON mapping.
+type Printer struct {
+	config PrinterConfig
+	fields fieldTree
+	out    io.Writer
+}
+
+// line is the printed JSON of an event.
+type line struct {
+	Pipe      string            `json:"pipe,omitempty"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Timestamp int64             `json:"timestamp"`
+	Meta      map[string]string `json:"meta,omitempty"`
+	Data      json.RawMessage   `json:"data"`
+}
+
+// NewPrinter creates a Printer writing to the output.
+func NewPrinter(config PrinterConfig, out io.Writer) (*Printer, error) {
+	if config.SamplingRate <= 0 || config.SamplingRate > 1 {
+		return nil, fmt.Errorf("the sampling rate must be in (0, 1], but got %v", config.SamplingRate)
+	}
+	return &Printer{
+		config: config,
+		fields: newFieldTree(config.Fields),
+		out:    out,
+	}, nil
+}
+
+// Print prints the sampled events, the lines of a batch are written in a single write.
+func (p *Printer) Print(pipe string, events event.BatchEvents) error {
+	var buf bytes.Buffer
+	for _, e := range events {
+		// #nosec G404 -- the sampling doesn't need the cryptographic random.
+		if p.config.SamplingRate < 1 && rand.Float64() >= p.config.SamplingRate {
+			continue
+		}
+		data, err := p.encode(pipe, e)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	_, err := p.out.Write(buf.Bytes())
+	return err
+}
+
+func (p *Printer) encode(pipe string, e *v1.SniffData) ([]byte, error) {
+	data, err := decodeData(e)
+	if err != nil {
+		return nil, err
+	}
+	l := &line{
+		Pipe:      pipe,
+		Name:      e.GetName(),
+		Type:      event.TypeName(e.GetType()),
+		Timestamp: e.GetTimestamp(),
+		Meta:      e.GetMeta(),
+		Data:      data,
+	}
+	if len(p.fields) == 0 {
+		return json.Marshal(l)
+	}
+	encoded, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(p.fields.project(value))
+}
+
+// fieldTree is the tree of the selected field paths, the nil subtree selects the whole field.
+type fieldTree map[string]fieldTree
+
+func newFieldTree(paths []string) fieldTree {
+	tree := make(fieldTree)
+	for _, path := range paths {
+		node := tree
+		keys := strings.Split(path, ".")
+		for i, key := range keys {
+			sub, exist := node[key]
+			if exist && sub == nil {
+				// the parent field has been selected.
+				break
+			}
+			if i == len(keys)-1 {
+				node[key] = nil
+				break
+			}
+			if !exist {
+				sub = make(fieldTree)
+				node[key] = sub
+			}
+			node = sub
+		}
+	}
+	return tree
+}
+
+// project keeps the selected fields of the value, the selection is applied to every element of the arrays.
+func (t fieldTree) project(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(t))
+		for key, sub := range t {
+			child, ok := v[key]
+			if !ok {
+				continue
+			}
+			if sub == nil {
+				result[key] = child
+			} else {
+				result[key] = sub.project(child)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			result = append(result, t.project(element))
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// stdout is shared by the printers, so the lines of the printers are not interleaved.
+var stdout = &lockedWriter{out: nopCloser{os.Stdout}}
+
+// OpenOutput opens the output, which is the standard output or a file opened in the append mode.
+// The writes to the output are serialized, so the output could be shared by the printers.
+func OpenOutput(output string) (io.WriteCloser, error) {
+	if output == "" || output == OutputStdout {
+		return nopCloser{stdout}, nil
+	}
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &lockedWriter{out: file}, nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+type lockedWriter struct {
+	lock sync.Mutex
+	out  io.WriteCloser
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.out.Write(p)
+}
+
+func (w *lockedWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.out.Close()
+}
@@ -0,0 +1,185 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+)
+
+// The max pending batches of a tap, the batches are dropped when the output could not keep up.
+const maxPendingBatches = 100
+
+// Tap temporarily mirrors the forwarded events of a pipe to the output. The events are printed
+// by the routine of the tap, so a slow output never blocks the sender.
+type Tap struct {
+	PrinterConfig
+	Pipe     string    `json:"pipe"`
+	ExpireAt time.Time `json:"expire_at"`
+
+	printer *Printer
+	timer   *time.Timer
+	pending chan event.BatchEvents
+	done    chan struct{} // closed when the tap is removed
+	stopped chan struct{} // closed when the pending batches are printed after removed
+	dropped int64
+}
+
+var (
+	lock   sync.RWMutex
+	taps   = make(map[string]*Tap)
+	active int32
+)
+
+// Start starts to tap the pipe until the duration expires, the previous tap of the pipe is replaced.
+func Start(pipe string, duration time.Duration, config PrinterConfig, out io.Writer) (*Tap, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("the duration must be positive")
+	}
+	printer, err := NewPrinter(config, out)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tap{
+		PrinterConfig: config,
+		Pipe:          pipe,
+		ExpireAt:      time.Now().Add(duration),
+		printer:       printer,
+		pending:       make(chan event.BatchEvents, maxPendingBatches),
+		done:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+	go t.run()
+	lock.Lock()
+	previous, replaced := taps[pipe]
+	if replaced {
+		previous.timer.Stop()
+	}
+	t.timer = time.AfterFunc(duration, func() {
+		remove(pipe, t)
+	})
+	taps[pipe] = t
+	atomic.StoreInt32(&active, int32(len(taps))) // #nosec G115
+	lock.Unlock()
+	if replaced {
+		previous.stop()
+	}
+	log.Logger.WithField("pipe", pipe).Infof("start to tap the pipe until %s", t.ExpireAt.Format(time.RFC3339))
+	return t, nil
+}
+
+// Stop stops the tap of the pipe, returns false when the pipe is not tapped.
+func Stop(pipe string) bool {
+	lock.RLock()
+	t, ok := taps[pipe]
+	lock.RUnlock()
+	if !ok {
+		return false
+	}
+	t.timer.Stop()
+	return remove(pipe, t)
+}
+
+func remove(pipe string, t *Tap) bool {
+	lock.Lock()
+	if taps[pipe] != t {
+		lock.Unlock()
+		return false
+	}
+	delete(taps, pipe)
+	atomic.StoreInt32(&active, int32(len(taps))) // #nosec G115
+	lock.Unlock()
+	t.stop()
+	log.Logger.WithField("pipe", pipe).Infof("the tap of the pipe is stopped")
+	return true
+}
+
+// stop waits for the pending batches to be printed, it is called once when the tap is removed or replaced.
+func (t *Tap) stop() {
+	close(t.done)
+	<-t.stopped
+	if dropped := atomic.LoadInt64(&t.dropped); dropped > 0 {
+		log.Logger.WithField("pipe", t.Pipe).Warnf("the tap dropped %d batches as the output could not keep up", dropped)
+	}
+}
+
+// run prints the pending batches until the tap is stopped.
+func (t *Tap) run() {
+	defer close(t.stopped)
+	for {
+		select {
+		case events := <-t.pending:
+			t.print(events)
+		case <-t.done:
+			for {
+				select {
+				case events := <-t.pending:
+					t.print(events)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (t *Tap) print(events event.BatchEvents) {
+	if err := t.printer.Print(t.Pipe, events); err != nil {
+		log.Logger.WithField("pipe", t.Pipe).Warnf("print the tapped events failure: %v", err)
+	}
+}
+
+// List returns the running taps sorted by the pipe.
+func List() []*Tap {
+	lock.RLock()
+	defer lock.RUnlock()
+	result := make([]*Tap, 0, len(taps))
+	for _, t := range taps {
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Pipe < result[j].Pipe
+	})
+	return result
+}
+
+// Mirror hands the events to the tap of the pipe without blocking, the events are dropped when the pending
+// batches of the tap are full. It costs an atomic read when no pipe is tapped.
+func Mirror(pipe string, events event.BatchEvents) {
+	if atomic.LoadInt32(&active) == 0 {
+		return
+	}
+	lock.RLock()
+	t, ok := taps[pipe]
+	lock.RUnlock()
+	if !ok {
+		return
+	}
+	select {
+	case t.pending <- events:
+	default:
+		atomic.AddInt64(&t.dropped, 1)
+	}
+}
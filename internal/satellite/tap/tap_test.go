@@ -0,0 +1,156 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+func TestPrintFields(t *testing.T) {
+	logData, err := proto.Marshal(&logging.LogData{Service: "svc-a", ServiceInstance: "instance-a", Endpoint: "/a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	printer, err := NewPrinter(PrinterConfig{SamplingRate: 1, Fields: []string{"type", "data.service"}}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = printer.Print("logpipe", event.BatchEvents{
+		{Type: v1.SniffType_Logging, Data: &v1.SniffData_LogList{LogList: &v1.BatchLogList{Logs: [][]byte{logData, logData}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"data":[{"service":"svc-a"},{"service":"svc-a"}],"type":"Logging"}`
+	if actual := strings.TrimSpace(out.String()); actual != expected {
+		t.Fatalf("expected %s, but got %s", expected, actual)
+	}
+}
+
+func TestPrintAll(t *testing.T) {
+	var out bytes.Buffer
+	printer, err := NewPrinter(PrinterConfig{SamplingRate: 1}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = printer.Print("zabbixpipe", event.BatchEvents{
+		event.NewExtensionEvent("zabbix", event.ZabbixRequestType, []byte(`{"request":"agent data"}`)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result["pipe"] != "zabbixpipe" || result["name"] != "zabbix" {
+		t.Fatalf("unexpected line: %s", out.String())
+	}
+	if data, ok := result["data"].(map[string]interface{}); !ok || data["request"] != "agent data" {
+		t.Fatalf("unexpected data: %s", out.String())
+	}
+}
+
+func TestSamplingRate(t *testing.T) {
+	for _, rate := range []float64{0, -1, 1.5} {
+		if _, err := NewPrinter(PrinterConfig{SamplingRate: rate}, &bytes.Buffer{}); err == nil {
+			t.Fatalf("the sampling rate %v should be invalid", rate)
+		}
+	}
+}
+
+func TestStartAndStop(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	var out bytes.Buffer
+	events := event.BatchEvents{event.NewExtensionEvent("zabbix", event.ZabbixRequestType, []byte(`{}`))}
+	Mirror("pipe", events)
+	if out.Len() != 0 {
+		t.Fatalf("the pipe is not tapped")
+	}
+	if _, err := Start("pipe", time.Minute, PrinterConfig{SamplingRate: 1}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if taps := List(); len(taps) != 1 || taps[0].Pipe != "pipe" {
+		t.Fatalf("unexpected taps: %v", taps)
+	}
+	Mirror("other", events)
+	Mirror("pipe", events)
+	// the pending events are printed before the tap is stopped.
+	if !Stop("pipe") || Stop("pipe") {
+		t.Fatalf("the tap should be stopped once")
+	}
+	if lines := strings.Count(out.String(), "\n"); lines != 1 {
+		t.Fatalf("expected only the events of the tapped pipe are printed, but got %d lines", lines)
+	}
+
+	if _, err := Start("pipe", 10*time.Millisecond, PrinterConfig{SamplingRate: 1}, &out); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if taps := List(); len(taps) != 0 {
+		t.Fatalf("the tap should be expired")
+	}
+}
+
+// blockingWriter blocks the writes until it is released.
+type blockingWriter struct {
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return len(p), nil
+}
+
+func TestMirrorNeverBlocks(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	out := &blockingWriter{release: make(chan struct{})}
+	if _, err := Start("blocked", time.Minute, PrinterConfig{SamplingRate: 1}, out); err != nil {
+		t.Fatal(err)
+	}
+	events := event.BatchEvents{event.NewExtensionEvent("zabbix", event.ZabbixRequestType, []byte(`{}`))}
+	mirrored := make(chan struct{})
+	go func() {
+		for i := 0; i < 2*maxPendingBatches; i++ {
+			Mirror("blocked", events)
+		}
+		close(mirrored)
+	}()
+	select {
+	case <-mirrored:
+	case <-time.After(time.Second):
+		t.Fatalf("the slow output blocks the mirror")
+	}
+	close(out.release)
+	if !Stop("blocked") {
+		t.Fatalf("the tap should be stopped")
+	}
+}
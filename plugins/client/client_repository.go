@@ -21,6 +21,7 @@ import (
 	"reflect"
 
 	"github.com/apache/skywalking-satellite/plugins/client/grpc"
+	"github.com/apache/skywalking-satellite/plugins/client/http"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
 	"github.com/apache/skywalking-satellite/plugins/client/api"
@@ -34,6 +35,7 @@ func RegisterClientPlugins() {
 		// Please register the client plugins at here.
 		new(kafka.Client),
 		new(grpc.Client),
+		new(http.Client),
 	}
 	for _, client := range clients {
 		plugin.RegisterPlugin(client)
@@ -0,0 +1,160 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/plugins/client/api"
+)
+
+const (
+	Name     = "http-client"
+	ShowName = "HTTP Client"
+)
+
+type Client struct {
+	config.CommonFields
+	ServerAddr         string            `mapstructure:"server_addr"`          // The HTTP server addresses, multiple addresses are split by ",".
+	Timeout            string            `mapstructure:"timeout"`              // The HTTP request timeout.
+	Headers            map[string]string `mapstructure:"headers"`              // The extra headers appended to every request.
+	Username           string            `mapstructure:"username"`             // The username of the basic authentication.
+	Password           string            `mapstructure:"password"`             // The password of the basic authentication.
+	ClientPemPath      string            `mapstructure:"client_pem_path"`      // The file path of client.pem. The config only works when using the https address.
+	ClientKeyPath      string            `mapstructure:"client_key_path"`      // The file path of client.key. The config only works when using the https address.
+	CaPemPath          string            `mapstructure:"ca_pem_path"`          // The file path oca.pem. The config only works when using the https address.
+	InsecureSkipVerify bool              `mapstructure:"insecure_skip_verify"` // Controls whether a client verifies the server's certificate chain and host name.
+	CheckPeriod        int               `mapstructure:"check_period"`         // How frequently to check the connection(second)
+
+	// components
+	status     api.ClientStatus
+	connection *Connection
+	listeners  []chan<- api.ClientStatus
+	ctx        context.Context    // Parent ctx
+	cancel     context.CancelFunc // Parent ctx cancel function
+}
+
+func (c *Client) Name() string {
+	return Name
+}
+
+func (c *Client) ShowName() string {
+	return ShowName
+}
+
+func (c *Client) Description() string {
+	return "The HTTP client is a sharing plugin to keep connection with the HTTP servers and delivery the data to it. " +
+		"The requests are sent to the server addresses by the round-robin policy."
+}
+
+func (c *Client) DefaultConfig() string {
+	return `
+# The HTTP server addresses (default http://localhost:12800), multiple addresses are split by ",".
+server_addr: http://localhost:12800
+
+# The HTTP request timeout.
+timeout: 5s
+
+# The extra headers appended to every request, such as the tenant or the authentication header.
+headers: {}
+
+# The username of the basic authentication, the basic authentication is disabled when it is empty.
+username: ""
+
+# The password of the basic authentication.
+password: ""
+
+# The file path of client.pem. The config only works when using the https address.
+client_pem_path: ""
+
+# The file path of client.key. The config only works when using the https address.
+client_key_path: ""
+
+# The file path oca.pem. The config only works when using the https address.
+ca_pem_path: ""
+
+# InsecureSkipVerify controls whether a client verifies the server's certificate chain and host name.
+insecure_skip_verify: true
+
+# How frequently to check the connection(second)
+check_period: 5
+`
+}
+
+func (c *Client) Prepare() error {
+	addresses := make([]string, 0)
+	for _, address := range strings.Split(c.ServerAddr, ",") {
+		if address = strings.TrimRight(strings.TrimSpace(address), "/"); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return fmt.Errorf("cannot init the http client: the server address is required")
+	}
+	timeout, err := time.ParseDuration(c.Timeout)
+	if err != nil {
+		return fmt.Errorf("cannot parse the http request timeout: %v", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.CaPemPath != "" || c.ClientPemPath != "" || c.InsecureSkipVerify {
+		tlsConfig, err := c.configTLS()
+		if err != nil {
+			return fmt.Errorf("cannot init the http client: %v", err)
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	c.connection = &Connection{
+		client:    &http.Client{Timeout: timeout, Transport: transport},
+		addresses: addresses,
+		headers:   c.Headers,
+		username:  c.Username,
+		password:  c.Password,
+		reporter:  c.reportError,
+	}
+	c.status = api.Connected
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+	c.listeners = make([]chan<- api.ClientStatus, 0)
+	return nil
+}
+
+func (c *Client) Close() error {
+	c.cancel()
+	defer log.Logger.Info("http client is closed")
+	c.connection.client.CloseIdleConnections()
+	return nil
+}
+
+func (c *Client) GetConnectedClient() interface{} {
+	return c.connection
+}
+
+func (c *Client) RegisterListener(listener chan<- api.ClientStatus) {
+	c.listeners = append(c.listeners, listener)
+}
+
+func (c *Client) Start() error {
+	go c.snifferServerStatus()
+	return nil
+}
@@ -0,0 +1,76 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+func (c *Client) configTLS() (tc *tls.Config, tlsErr error) {
+	tlsConfig := new(tls.Config)
+	tlsConfig.Renegotiation = tls.RenegotiateNever
+	tlsConfig.InsecureSkipVerify = c.InsecureSkipVerify // #nosec G402 -- controlled by the configuration
+	if c.CaPemPath != "" {
+		if err := checkTLSFile(c.CaPemPath); err != nil {
+			return nil, err
+		}
+		caPem, err := os.ReadFile(c.CaPemPath)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("failed to append certificates")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if c.ClientKeyPath != "" && c.ClientPemPath != "" {
+		if err := checkTLSFile(c.ClientKeyPath); err != nil {
+			return nil, err
+		}
+		if err := checkTLSFile(c.ClientPemPath); err != nil {
+			return nil, err
+		}
+		clientPem, err := tls.LoadX509KeyPair(c.ClientPemPath, c.ClientKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientPem}
+	}
+	return tlsConfig, nil
+}
+
+func checkTLSFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if stat.Size() == 0 {
+		return fmt.Errorf("the TLS file is illegal: %s", path)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/plugins/client/api"
+)
+
+func (c *Client) snifferServerStatus() {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+	timeTicker := time.NewTicker(time.Duration(c.CheckPeriod) * time.Second)
+	for {
+		select {
+		case <-timeTicker.C:
+			if c.anyServerReachable() {
+				c.updateStatus(api.Connected)
+			} else {
+				c.updateStatus(api.Disconnect)
+			}
+		case <-ctx.Done():
+			timeTicker.Stop()
+			return
+		}
+	}
+}
+
+// anyServerReachable checks whether any server address could be connected by the TCP protocol.
+func (c *Client) anyServerReachable() bool {
+	for _, address := range c.connection.addresses {
+		u, err := url.Parse(address)
+		if err != nil {
+			continue
+		}
+		host := u.Host
+		if u.Port() == "" {
+			if u.Scheme == "https" {
+				host = net.JoinHostPort(u.Hostname(), "443")
+			} else {
+				host = net.JoinHostPort(u.Hostname(), "80")
+			}
+		}
+		conn, err := net.DialTimeout("tcp", host, time.Second)
+		if err != nil {
+			log.Logger.Debugf("the http server %s is unreachable: %v", address, err)
+			continue
+		}
+		_ = conn.Close()
+		return true
+	}
+	return false
+}
+
+func (c *Client) reportError(err error) {
+	if err == nil {
+		return
+	}
+	// only the connection failure would change the status, such as the connection refused
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		c.updateStatus(api.Disconnect)
+	}
+}
+
+func (c *Client) updateStatus(clientStatus api.ClientStatus) {
+	if c.status != clientStatus {
+		c.status = clientStatus
+		for _, listener := range c.listeners {
+			listener <- c.status
+		}
+	}
+}
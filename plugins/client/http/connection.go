@@ -0,0 +1,62 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// Connection is the connected client of the HTTP client plugin, which is shared with the forwarders.
+type Connection struct {
+	client    *http.Client
+	addresses []string
+	headers   map[string]string
+	username  string
+	password  string
+	index     uint32
+	reporter  func(err error)
+}
+
+// NewRequest builds a request to the path of the next server address, and appends the configured headers.
+func (c *Connection) NewRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	address := c.addresses[int(atomic.AddUint32(&c.index, 1)%uint32(len(c.addresses)))] // #nosec G115
+	req, err := http.NewRequestWithContext(ctx, method, address+path, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return req, nil
+}
+
+// Do sends the request, the connection failure would be reported to change the client status.
+func (c *Connection) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		c.reporter(err)
+		return nil, err
+	}
+	return resp, nil
+}
@@ -18,8 +18,11 @@
 package api
 
 import (
+	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/go-multierror"
+
 	"google.golang.org/grpc"
 	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
 
@@ -33,6 +36,8 @@ type Forwarder interface {
 	// Prepare do some preparation works, such as create a stub in gRPC and create a producer in Kafka.
 	Prepare(connection interface{}) error
 	// Forward the batch events to the external services, such as Kafka MQ and SkyWalking OAP cluster.
+	// The Forward is called concurrently by the partitions and the in-flight batches of the sender,
+	// so it must be safe for the concurrent calls.
 	Forward(batch event.BatchEvents) error
 	// SyncForward the single event to the external service with sync forward
 	// The returned result grpc.ClientStream is the stream initiated by satellite to oap server,
@@ -44,6 +49,37 @@ type Forwarder interface {
 	SupportedSyncInvoke() bool
 }
 
+// Closer is an optional interface of the forwarders holding the resources, such as the streams and the files.
+// The Close is called when the pipe is shutting down, after the buffered events are forwarded.
+type Closer interface {
+	Close() error
+}
+
+// CloseForwarders closes the forwarders implementing the Closer.
+func CloseForwarders(forwarders []Forwarder) error {
+	var err error
+	for _, f := range forwarders {
+		if closer, ok := f.(Closer); ok {
+			if e := closer.Close(); e != nil {
+				err = multierror.Append(err, fmt.Errorf("close %s error: %v", f.Name(), e))
+			}
+		}
+	}
+	return err
+}
+
+// ConcurrentBatchesRequirer is an optional interface of the forwarders finishing a batch after the following batches
+// are sent, such as the forwarders reusing the streams, so the batches must be forwarded concurrently.
+type ConcurrentBatchesRequirer interface {
+	RequiresConcurrentBatches() bool
+}
+
+// RequiresConcurrentBatches returns true when the forwarder could not finish a batch before the following batches are sent.
+func RequiresConcurrentBatches(f Forwarder) bool {
+	requirer, ok := f.(ConcurrentBatchesRequirer)
+	return ok && requirer.RequiresConcurrentBatches()
+}
+
 // ForwardFunc represent the Forward() in Forwarder
 type ForwardFunc func(batch event.BatchEvents) error
 
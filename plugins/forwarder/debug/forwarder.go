@@ -0,0 +1,116 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package debug
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/internal/satellite/tap"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "debug-forwarder"
+	ShowName = "Debug Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	Output       string   `mapstructure:"output"`        // The output of the events, "stdout" or a file path.
+	SamplingRate float64  `mapstructure:"sampling_rate"` // The ratio of the printed events, from 0(exclusive) to 1.
+	Fields       []string `mapstructure:"fields"`        // The dotted paths of the printed fields, all fields are printed when it is empty.
+
+	printer *tap.Printer
+	output  io.Closer
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a forwarder to print the events for inspecting the received data, every line is the JSON of an event. " +
+		"The serialized data, such as the segments and the logs, is decoded to the protobuf JSON mapping. " +
+		"It accepts the events of all types, so it could be added into any pipe besides the other forwarders, and the client is not required."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The output of the events, "stdout" or a file path, the file is opened in the append mode.
+output: "stdout"
+# The ratio of the printed events, from 0(exclusive) to 1.
+sampling_rate: 1
+# The dotted paths of the printed fields, such as "type" and "data.service", the selection applies to every element of the arrays.
+# All fields are printed when it is empty.
+fields: []
+`
+}
+
+func (f *Forwarder) Prepare(_ interface{}) error {
+	out, err := tap.OpenOutput(f.Output)
+	if err != nil {
+		return err
+	}
+	printer, err := tap.NewPrinter(tap.PrinterConfig{SamplingRate: f.SamplingRate, Fields: f.Fields}, out)
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	f.printer = printer
+	f.output = out
+	return nil
+}
+
+// Close closes the output file, the stdout is never closed.
+func (f *Forwarder) Close() error {
+	if f.output == nil {
+		return nil
+	}
+	return f.output.Close()
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	// the debug output never fails the batch, so the other forwarders are not affected.
+	if err := f.printer.Print(f.PipeName, batch); err != nil {
+		log.Logger.WithField("pipe", f.PipeName).Warnf("%s print the events failure: %v", f.Name(), err)
+	}
+	return nil
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.AnyType
+}
+
+func (f *Forwarder) SyncForward(_ *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,223 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+)
+
+// BulkConfig is the common config of the Elasticsearch forwarders.
+type BulkConfig struct {
+	URI           string `mapstructure:"uri"`            // The bulk API URI.
+	Index         string `mapstructure:"index"`          // The index template, supports the {service} and {date} placeholders.
+	DateFormat    string `mapstructure:"date_format"`    // The date format of the {date} placeholder in the Go time layout.
+	MaxRetries    int    `mapstructure:"max_retries"`    // The max retry times of the failed documents in a batch.
+	RetryInterval int    `mapstructure:"retry_interval"` // The interval between two retries, the time unit is millisecond.
+}
+
+// DefaultBulkConfig is the default config of the BulkConfig, the index template is provided by the forwarders.
+const DefaultBulkConfig = `
+# The bulk API URI.
+uri: "/_bulk"
+# The date format of the {date} placeholder in the Go time layout, the date is in UTC.
+date_format: "2006.01.02"
+# The max retry times of the failed documents in a batch, the documents rejected by 429 or 5xx are retried.
+max_retries: 3
+# The interval between two retries, the time unit is millisecond.
+retry_interval: 1000
+`
+
+// Document is an Elasticsearch document to create. The document is created with an ID,
+// so the retried documents would not be duplicated.
+type Document struct {
+	Index  string
+	ID     string
+	Source []byte
+}
+
+// BulkWriter writes the documents by the bulk API, and only retries the failed documents.
+type BulkWriter struct {
+	name          string
+	config        *BulkConfig
+	connection    *http_client.Connection
+	indexTemplate *indexTemplate
+}
+
+// NewBulkWriter creates a BulkWriter of the forwarder.
+func NewBulkWriter(name string, config *BulkConfig, connection *http_client.Connection) (*BulkWriter, error) {
+	template, err := newIndexTemplate(config.Index, config.DateFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &BulkWriter{
+		name:          name,
+		config:        config,
+		connection:    connection,
+		indexTemplate: template,
+	}, nil
+}
+
+// IndexName returns the index name of the service at the time.
+func (w *BulkWriter) IndexName(service string, t time.Time) string {
+	return w.indexTemplate.build(service, t)
+}
+
+// Write creates the documents, the error would be returned when some documents are still failed after retrying.
+func (w *BulkWriter) Write(docs []*Document) error {
+	var err error
+	for i := 0; i <= w.config.MaxRetries && len(docs) > 0; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(w.config.RetryInterval) * time.Millisecond)
+		}
+		docs, err = w.bulk(docs)
+		if err != nil {
+			log.Logger.Warnf("%s bulk write failure, retried %d times: %v", w.name, i, err)
+		}
+	}
+	return err
+}
+
+// bulkResponse is the response of the bulk API, the item is keyed by the action.
+type bulkResponse struct {
+	Errors bool                           `json:"errors"`
+	Items  []map[string]*bulkItemResponse `json:"items"`
+}
+
+type bulkItemResponse struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// bulk sends the documents, and returns the documents need to retry.
+func (w *BulkWriter) bulk(docs []*Document) ([]*Document, error) {
+	var body bytes.Buffer
+	for _, doc := range docs {
+		action, err := json.Marshal(map[string]map[string]string{"create": {"_index": doc.Index, "_id": doc.ID}})
+		if err != nil {
+			return nil, err
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(doc.Source)
+		body.WriteByte('\n')
+	}
+	req, err := w.connection.NewRequest(context.Background(), http.MethodPost, w.config.URI, &body)
+	if err != nil {
+		return docs, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := w.connection.Do(req)
+	if err != nil {
+		return docs, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err = fmt.Errorf("status code: %d, response: %s", resp.StatusCode, msg)
+		if isRetryable(resp.StatusCode) {
+			return docs, err
+		}
+		// the whole request is rejected, such as the unauthorized request, so the batch is failed without retrying.
+		return nil, err
+	}
+	result := &bulkResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return docs, fmt.Errorf("decode the bulk response failure: %v", err)
+	}
+	if !result.Errors {
+		return nil, nil
+	}
+	if len(result.Items) != len(docs) {
+		return docs, fmt.Errorf("the bulk response has %d items, but %d documents are sent", len(result.Items), len(docs))
+	}
+	var retries []*Document
+	var lastError string
+	for i, item := range result.Items {
+		for _, r := range item {
+			// the conflict means the document is created already.
+			if r.Status/100 == 2 || r.Status == http.StatusConflict {
+				continue
+			}
+			if isRetryable(r.Status) {
+				retries = append(retries, docs[i])
+				lastError = string(r.Error)
+				continue
+			}
+			log.Logger.Errorf("%s drop the document of the index %s, status: %d, error: %s", w.name, docs[i].Index, r.Status, r.Error)
+		}
+	}
+	if len(retries) == 0 {
+		return nil, nil
+	}
+	return retries, fmt.Errorf("%d documents are failed, the last error: %s", len(retries), lastError)
+}
+
+func isRetryable(status int) bool {
+	return status == http.StatusTooManyRequests || status/100 == 5
+}
+
+// indexTemplate builds the index names with the {service} and {date} placeholders.
+type indexTemplate struct {
+	template   string
+	dateFormat string
+}
+
+func newIndexTemplate(template, dateFormat string) (*indexTemplate, error) {
+	if template == "" {
+		return nil, fmt.Errorf("the index is required")
+	}
+	if strings.Contains(template, "{date}") && dateFormat == "" {
+		return nil, fmt.Errorf("the date format is required when using the {date} placeholder")
+	}
+	return &indexTemplate{template: template, dateFormat: dateFormat}, nil
+}
+
+func (t *indexTemplate) build(service string, time time.Time) string {
+	index := t.template
+	if strings.Contains(index, "{service}") {
+		index = strings.ReplaceAll(index, "{service}", sanitizeIndexName(service))
+	}
+	if strings.Contains(index, "{date}") {
+		index = strings.ReplaceAll(index, "{date}", time.UTC().Format(t.dateFormat))
+	}
+	return index
+}
+
+// sanitizeIndexName lowercases the name and replaces the characters not allowed in the index names.
+func sanitizeIndexName(name string) string {
+	if name == "" {
+		return "unknown"
+	}
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\\', '/', '*', '?', '"', '<', '>', '|', ' ', ',', '#', ':':
+			return '_'
+		}
+		return r
+	}, strings.ToLower(name))
+}
@@ -0,0 +1,157 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+)
+
+// bulkStandIn is a local stand-in of the bulk API, which responds the status of each document by its ID.
+type bulkStandIn struct {
+	lock     sync.Mutex
+	statuses map[string][]int
+	requests [][]string
+}
+
+func (s *bulkStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var ids []string
+	items := make([]map[string]interface{}, 0)
+	hasErrors := false
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		action := make(map[string]map[string]string)
+		if err := json.Unmarshal(scanner.Bytes(), &action); err != nil || !scanner.Scan() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id := action["create"]["_id"]
+		ids = append(ids, id)
+		status := http.StatusCreated
+		if remains := s.statuses[id]; len(remains) > 0 {
+			status, s.statuses[id] = remains[0], remains[1:]
+		}
+		item := map[string]interface{}{"status": status}
+		if status/100 != 2 {
+			hasErrors = true
+			item["error"] = map[string]string{"type": "test_exception"}
+		}
+		items = append(items, map[string]interface{}{"create": item})
+	}
+	s.requests = append(s.requests, ids)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": hasErrors, "items": items})
+}
+
+func newWriter(t *testing.T, standIn http.Handler) *BulkWriter {
+	log.Init(&log.LoggerConfig{})
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	client := &http_client.Client{ServerAddr: server.URL, Timeout: "5s", CheckPeriod: 5}
+	if err := client.Prepare(); err != nil {
+		t.Fatalf("cannot prepare the http client: %v", err)
+	}
+	writer, err := NewBulkWriter("test", &BulkConfig{
+		URI:           "/_bulk",
+		Index:         "logs-{service}-{date}",
+		DateFormat:    "2006.01.02",
+		MaxRetries:    2,
+		RetryInterval: 10,
+	}, client.GetConnectedClient().(*http_client.Connection))
+	if err != nil {
+		t.Fatalf("cannot create the bulk writer: %v", err)
+	}
+	return writer
+}
+
+func TestRetryFailedDocuments(t *testing.T) {
+	standIn := &bulkStandIn{statuses: map[string][]int{
+		"retried":  {http.StatusTooManyRequests, http.StatusServiceUnavailable},
+		"rejected": {http.StatusBadRequest},
+		"conflict": {http.StatusConflict},
+	}}
+	writer := newWriter(t, standIn)
+	docs := []*Document{
+		{Index: "logs", ID: "created", Source: []byte(`{}`)},
+		{Index: "logs", ID: "retried", Source: []byte(`{}`)},
+		{Index: "logs", ID: "rejected", Source: []byte(`{}`)},
+		{Index: "logs", ID: "conflict", Source: []byte(`{}`)},
+	}
+	if err := writer.Write(docs); err != nil {
+		t.Fatalf("expected the documents are written, but got: %v", err)
+	}
+	if len(standIn.requests) != 3 {
+		t.Fatalf("expected 3 bulk requests, but got %v", standIn.requests)
+	}
+	for _, ids := range standIn.requests[1:] {
+		if len(ids) != 1 || ids[0] != "retried" {
+			t.Fatalf("expected only the failed document is retried, but got %v", standIn.requests)
+		}
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	standIn := &bulkStandIn{statuses: map[string][]int{
+		"retried": {http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+	}}
+	writer := newWriter(t, standIn)
+	if err := writer.Write([]*Document{{Index: "logs", ID: "retried", Source: []byte(`{}`)}}); err == nil {
+		t.Fatalf("expected the error after the retries are exhausted")
+	}
+}
+
+func TestRequestRejected(t *testing.T) {
+	var requests int32
+	writer := newWriter(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	if err := writer.Write([]*Document{{Index: "logs", ID: "rejected", Source: []byte(`{}`)}}); err == nil {
+		t.Fatalf("expected the error when the request is rejected")
+	}
+	if count := atomic.LoadInt32(&requests); count != 1 {
+		t.Fatalf("expected the rejected request is not retried, but got %d requests", count)
+	}
+}
+
+func TestIndexName(t *testing.T) {
+	writer := newWriter(t, &bulkStandIn{})
+	tests := []struct {
+		service string
+		want    string
+	}{
+		{service: "Gateway", want: "logs-gateway-2024.03.05"},
+		{service: "group::svc a", want: "logs-group__svc_a-2024.03.05"},
+		{service: "", want: "logs-unknown-2024.03.05"},
+	}
+	for _, tt := range tests {
+		if got := writer.IndexName(tt.service, time.Date(2024, 3, 5, 23, 0, 0, 0, time.UTC)); got != tt.want {
+			t.Errorf("expected index %s, but got %s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelog
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-log-elasticsearch-forwarder"
+	ShowName = "Native Log Elasticsearch Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	elasticsearch.BulkConfig `mapstructure:",squash"`
+
+	writer *elasticsearch.BulkWriter
+}
+
+// logDocument is the document of a native log.
+type logDocument struct {
+	Timestamp       string            `json:"@timestamp"`
+	Service         string            `json:"service"`
+	ServiceInstance string            `json:"service_instance,omitempty"`
+	Endpoint        string            `json:"endpoint,omitempty"`
+	Layer           string            `json:"layer,omitempty"`
+	TraceID         string            `json:"trace_id,omitempty"`
+	SegmentID       string            `json:"segment_id,omitempty"`
+	SpanID          *int32            `json:"span_id,omitempty"`
+	BodyType        string            `json:"body_type,omitempty"`
+	Body            string            `json:"body"`
+	Tags            map[string]string `json:"tags,omitempty"`
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization forwarder to write the SkyWalking native logs into the Elasticsearch or OpenSearch by the bulk API. " +
+		"The document ID is the hash of the log, so the retried logs would not be duplicated. " +
+		"The basic authentication could be configured in the http-client, and the API key could be configured " +
+		"as the Authorization header of the http-client, such as \"ApiKey <key>\"."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The index template, supports the {service} and {date} placeholders.
+index: "skywalking-logs-{date}"` + elasticsearch.DefaultBulkConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	writer, err := elasticsearch.NewBulkWriter(f.Name(), &f.BulkConfig, client)
+	if err != nil {
+		return err
+	}
+	f.writer = writer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	docs := make([]*elasticsearch.Document, 0)
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_LogList)
+		if !ok {
+			continue
+		}
+		for _, l := range data.LogList.GetLogs() {
+			doc, err := f.buildDocument(l)
+			if err != nil {
+				log.Logger.Warnf("%s build the log document failure: %v", f.Name(), err)
+				continue
+			}
+			docs = append(docs, doc)
+		}
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	return f.writer.Write(docs)
+}
+
+func (f *Forwarder) buildDocument(data []byte) (*elasticsearch.Document, error) {
+	l := &logging.LogData{}
+	if err := proto.Unmarshal(data, l); err != nil {
+		return nil, err
+	}
+	t := time.Now()
+	if l.GetTimestamp() > 0 {
+		t = time.UnixMilli(l.GetTimestamp())
+	}
+	doc := &logDocument{
+		Timestamp:       t.UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		Service:         l.GetService(),
+		ServiceInstance: l.GetServiceInstance(),
+		Endpoint:        l.GetEndpoint(),
+		Layer:           l.GetLayer(),
+	}
+	if tc := l.GetTraceContext(); tc.GetTraceId() != "" {
+		spanID := tc.GetSpanId()
+		doc.TraceID, doc.SegmentID, doc.SpanID = tc.GetTraceId(), tc.GetTraceSegmentId(), &spanID
+	}
+	switch body := l.GetBody(); {
+	case body.GetText() != nil:
+		doc.BodyType, doc.Body = "text", body.GetText().GetText()
+	case body.GetJson() != nil:
+		doc.BodyType, doc.Body = "json", body.GetJson().GetJson()
+	case body.GetYaml() != nil:
+		doc.BodyType, doc.Body = "yaml", body.GetYaml().GetYaml()
+	}
+	if tags := l.GetTags().GetData(); len(tags) > 0 {
+		doc.Tags = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			doc.Tags[tag.GetKey()] = tag.GetValue()
+		}
+	}
+	source, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	hash := fnv.New128a()
+	_, _ = hash.Write(data)
+	return &elasticsearch.Document{
+		Index:  f.writer.IndexName(l.GetService(), t),
+		ID:     hex.EncodeToString(hash.Sum(nil)),
+		Source: source,
+	}, nil
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_Logging
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
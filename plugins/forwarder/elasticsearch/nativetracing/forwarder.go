@@ -0,0 +1,157 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativetracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-tracing-elasticsearch-forwarder"
+	ShowName = "Native Tracing Elasticsearch Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	elasticsearch.BulkConfig `mapstructure:",squash"`
+
+	writer *elasticsearch.BulkWriter
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization forwarder to write the SkyWalking native segments into the Elasticsearch or OpenSearch by the bulk API. " +
+		"The document is the segment in the protobuf JSON mapping with the original field names " +
+		"and an @timestamp field of the segment start time, and the document ID is the segment ID. " +
+		"The authentication is configured in the http-client as same as the native-log-elasticsearch-forwarder."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The index template, supports the {service} and {date} placeholders.
+index: "skywalking-segments-{date}"` + elasticsearch.DefaultBulkConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	writer, err := elasticsearch.NewBulkWriter(f.Name(), &f.BulkConfig, client)
+	if err != nil {
+		return err
+	}
+	f.writer = writer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	docs := make([]*elasticsearch.Document, 0, len(batch))
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_Segment)
+		if !ok {
+			continue
+		}
+		doc, err := f.buildDocument(data.Segment)
+		if err != nil {
+			log.Logger.Warnf("%s build the segment document failure: %v", f.Name(), err)
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	return f.writer.Write(docs)
+}
+
+func (f *Forwarder) buildDocument(data []byte) (*elasticsearch.Document, error) {
+	segment := &agent.SegmentObject{}
+	if err := proto.Unmarshal(data, segment); err != nil {
+		return nil, err
+	}
+	content, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(segment)
+	if err != nil {
+		return nil, err
+	}
+	source := make(map[string]interface{})
+	if err := json.Unmarshal(content, &source); err != nil {
+		return nil, err
+	}
+	t := segmentStartTime(segment)
+	source["@timestamp"] = t.UTC().Format("2006-01-02T15:04:05.000Z07:00")
+	result, err := json.Marshal(source)
+	if err != nil {
+		return nil, err
+	}
+	return &elasticsearch.Document{
+		Index:  f.writer.IndexName(segment.GetService(), t),
+		ID:     segment.GetTraceSegmentId(),
+		Source: result,
+	}, nil
+}
+
+// segmentStartTime returns the earliest start time of the spans.
+func segmentStartTime(segment *agent.SegmentObject) time.Time {
+	var start int64
+	for _, span := range segment.GetSpans() {
+		if start == 0 || (span.GetStartTime() > 0 && span.GetStartTime() < start) {
+			start = span.GetStartTime()
+		}
+	}
+	if start == 0 {
+		return time.Now()
+	}
+	return time.UnixMilli(start)
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_TracingType
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
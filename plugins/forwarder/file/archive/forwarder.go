@@ -0,0 +1,188 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package archive
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "archive-file-forwarder"
+	ShowName = "Archive File Forwarder"
+)
+
+// The supported file formats.
+const (
+	formatJSON     = "json"
+	formatProtobuf = "protobuf"
+)
+
+// The supported compressions.
+const (
+	compressionNone = "none"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	Directory        string `mapstructure:"directory"`         // The directory of the archive files.
+	FilePrefix       string `mapstructure:"file_prefix"`       // The prefix of the archive file names, the pipe name is used when it is empty.
+	Format           string `mapstructure:"format"`            // The file format, supports "json" and "protobuf".
+	Compression      string `mapstructure:"compression"`       // The compression of the files, supports "none", "gzip" and "zstd".
+	MaxFileSize      int64  `mapstructure:"max_file_size"`     // The max size of a file in MB, the file is rotated when reaching it.
+	RotationInterval int    `mapstructure:"rotation_interval"` // The max seconds of a file to be written, the file is rotated when reaching it.
+	MaxAge           int    `mapstructure:"max_age"`           // The max hours to retain the rotated files, 0 means no limit.
+	MaxFiles         int    `mapstructure:"max_files"`         // The max count of the retained rotated files, 0 means no limit.
+
+	writer *rotatingWriter
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a forwarder to archive the events of all types into the local files, " +
+		"the files are rotated by the size and time, and the expired files are removed by the retention limits. " +
+		"In the json format, every line is a SniffData in the protobuf JSON mapping. " +
+		"In the protobuf format, every SniffData is prefixed by its varint encoded length. " +
+		"Every forwarded batch is written as an independent compression member, so the files are always readable."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The directory of the archive files.
+directory: "archive"
+# The prefix of the archive file names, the pipe name is used when it is empty.
+file_prefix: ""
+# The file format, supports "json" and "protobuf".
+format: "json"
+# The compression of the files, supports "none", "gzip" and "zstd".
+compression: "gzip"
+# The max size of a file in MB, the file is rotated when reaching it.
+max_file_size: 100
+# The max seconds of a file to be written, the file is rotated when reaching it.
+rotation_interval: 3600
+# The max hours to retain the rotated files, 0 means no limit.
+max_age: 168
+# The max count of the retained rotated files, 0 means no limit.
+max_files: 0
+`
+}
+
+func (f *Forwarder) Prepare(_ interface{}) error {
+	if f.Format != formatJSON && f.Format != formatProtobuf {
+		return fmt.Errorf("unsupported format: %s", f.Format)
+	}
+	if f.Compression != compressionNone && f.Compression != compressionGzip && f.Compression != compressionZstd {
+		return fmt.Errorf("unsupported compression: %s", f.Compression)
+	}
+	if f.MaxFileSize <= 0 || f.RotationInterval <= 0 {
+		return fmt.Errorf("the max_file_size and rotation_interval must be positive")
+	}
+	prefix := f.FilePrefix
+	if prefix == "" {
+		prefix = f.PipeName
+	}
+	extension := ".jsonl"
+	if f.Format == formatProtobuf {
+		extension = ".pb"
+	}
+	w, err := newRotatingWriter(&rotatingConfig{
+		directory:   f.Directory,
+		prefix:      prefix,
+		extension:   extension,
+		compression: f.Compression,
+		maxSize:     f.MaxFileSize * 1024 * 1024,
+		interval:    time.Duration(f.RotationInterval) * time.Second,
+		maxAge:      time.Duration(f.MaxAge) * time.Hour,
+		maxFiles:    f.MaxFiles,
+	})
+	if err != nil {
+		return err
+	}
+	f.writer = w
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	var buf bytes.Buffer
+	for _, e := range batch {
+		if err := f.encode(&buf, e); err != nil {
+			log.Logger.Warnf("%s encode the %s event failure: %v", f.Name(), event.TypeName(e.GetType()), err)
+		}
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return f.writer.write(buf.Bytes())
+}
+
+// Close closes the current archive file when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	return f.writer.close()
+}
+
+func (f *Forwarder) encode(buf *bytes.Buffer, e *v1.SniffData) error {
+	if f.Format == formatJSON {
+		data, err := protojson.Marshal(e)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+		return nil
+	}
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	buf.Write(protowire.AppendVarint(nil, uint64(len(data))))
+	buf.Write(data)
+	return nil
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.AnyType
+}
+
+func (f *Forwarder) SyncForward(_ *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,238 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package archive
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+const fileTimeLayout = "20060102T150405.000"
+
+type rotatingConfig struct {
+	directory   string
+	prefix      string
+	extension   string
+	compression string
+	maxSize     int64
+	interval    time.Duration
+	maxAge      time.Duration
+	maxFiles    int
+}
+
+// rotatingWriter writes the data into the current file, and rotates the files by the size and time.
+// The file is rotated by a timer when reaching the interval, even no more data is written.
+type rotatingWriter struct {
+	config      *rotatingConfig
+	namePattern *regexp.Regexp
+
+	lock   sync.Mutex
+	file   *os.File
+	size   int64
+	timer  *time.Timer
+	closed bool
+
+	gzipWriter  *gzip.Writer
+	zstdEncoder *zstd.Encoder
+}
+
+func newRotatingWriter(c *rotatingConfig) (*rotatingWriter, error) {
+	if err := os.MkdirAll(c.directory, 0o755); err != nil {
+		return nil, err
+	}
+	if c.compression == compressionGzip {
+		c.extension += ".gz"
+	} else if c.compression == compressionZstd {
+		c.extension += ".zst"
+	}
+	w := &rotatingWriter{
+		config: c,
+		namePattern: regexp.MustCompile("^" + regexp.QuoteMeta(c.prefix+"-") +
+			`\d{8}T\d{6}\.\d{3}(-\d+)?` + regexp.QuoteMeta(c.extension) + "$"),
+	}
+	switch c.compression {
+	case compressionGzip:
+		w.gzipWriter = gzip.NewWriter(io.Discard)
+	case compressionZstd:
+		encoder, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		w.zstdEncoder = encoder
+	}
+	w.cleanup()
+	return w, nil
+}
+
+// write the data as an independent compression member, so the file is readable even the satellite is killed.
+func (w *rotatingWriter) write(data []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.closed {
+		return fmt.Errorf("the archive writer is closed")
+	}
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	counter := &countingWriter{writer: w.file}
+	var err error
+	switch w.config.compression {
+	case compressionGzip:
+		w.gzipWriter.Reset(counter)
+		err = writeAndClose(w.gzipWriter, data)
+	case compressionZstd:
+		w.zstdEncoder.Reset(counter)
+		err = writeAndClose(w.zstdEncoder, data)
+	default:
+		_, err = counter.Write(data)
+	}
+	w.size += counter.count
+	if err != nil {
+		// the broken file would not be appended anymore.
+		w.rotate()
+		return err
+	}
+	if w.size >= w.config.maxSize {
+		w.rotate()
+	}
+	return nil
+}
+
+func (w *rotatingWriter) open() error {
+	now := time.Now()
+	base := w.config.prefix + "-" + now.Format(fileTimeLayout)
+	name := base + w.config.extension
+	for i := 1; ; i++ {
+		file, err := os.OpenFile(filepath.Join(w.config.directory, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			w.file, w.size = file, 0
+			w.timer = time.AfterFunc(w.config.interval, func() {
+				w.rotateExpired(file)
+			})
+			return nil
+		}
+		if !os.IsExist(err) {
+			return err
+		}
+		name = fmt.Sprintf("%s-%d%s", base, i, w.config.extension)
+	}
+}
+
+// rotateExpired rotates the file when it is still being written after the interval.
+func (w *rotatingWriter) rotateExpired(file *os.File) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if w.file == file {
+		w.rotate()
+	}
+}
+
+// close closes the current file, and the writer could not be written anymore.
+func (w *rotatingWriter) close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.closed = true
+	if w.file == nil {
+		return nil
+	}
+	w.timer.Stop()
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
+
+func (w *rotatingWriter) rotate() {
+	w.timer.Stop()
+	if err := w.file.Close(); err != nil {
+		log.Logger.Warnf("close the archive file %s failure: %v", w.file.Name(), err)
+	}
+	w.file = nil
+	w.cleanup()
+}
+
+// cleanup removes the rotated files exceeding the retention limits.
+func (w *rotatingWriter) cleanup() {
+	if w.config.maxAge <= 0 && w.config.maxFiles <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(w.config.directory)
+	if err != nil {
+		log.Logger.Warnf("read the archive directory %s failure: %v", w.config.directory, err)
+		return
+	}
+	files := make([]os.FileInfo, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !w.namePattern.MatchString(entry.Name()) {
+			continue
+		}
+		if w.file != nil && filepath.Join(w.config.directory, entry.Name()) == w.file.Name() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, info)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	removeCount := 0
+	if w.config.maxFiles > 0 && len(files) > w.config.maxFiles {
+		removeCount = len(files) - w.config.maxFiles
+	}
+	for i, info := range files {
+		if i >= removeCount && (w.config.maxAge <= 0 || time.Since(info.ModTime()) < w.config.maxAge) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(w.config.directory, info.Name())); err != nil {
+			log.Logger.Warnf("remove the expired archive file %s failure: %v", info.Name(), err)
+		}
+	}
+}
+
+func writeAndClose(writer io.WriteCloser, data []byte) error {
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.writer.Write(p)
+	c.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,195 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+func newWriter(t *testing.T, c *rotatingConfig) *rotatingWriter {
+	log.Init(&log.LoggerConfig{})
+	c.directory = t.TempDir()
+	w, err := newRotatingWriter(c)
+	if err != nil {
+		t.Fatalf("cannot create the writer: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = w.close()
+	})
+	return w
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cannot read the directory: %v", err)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestFileNaming(t *testing.T) {
+	w := newWriter(t, &rotatingConfig{prefix: "pipe", extension: ".jsonl", compression: compressionGzip, maxSize: 1, interval: time.Hour})
+	// every write reaches the max size, so the files are opened in the same millisecond would be suffixed.
+	for i := 0; i < 3; i++ {
+		if err := w.write([]byte("data\n")); err != nil {
+			t.Fatalf("write error: %v", err)
+		}
+	}
+	pattern := regexp.MustCompile(`^pipe-\d{8}T\d{6}\.\d{3}(-\d+)?\.jsonl\.gz$`)
+	names := listFiles(t, w.config.directory)
+	if len(names) != 3 {
+		t.Fatalf("expected 3 files, but got %v", names)
+	}
+	for _, name := range names {
+		if !pattern.MatchString(name) || !w.namePattern.MatchString(name) {
+			t.Errorf("unexpected file name: %s", name)
+		}
+	}
+}
+
+func TestSizeRotation(t *testing.T) {
+	w := newWriter(t, &rotatingConfig{prefix: "pipe", extension: ".jsonl", compression: compressionNone, maxSize: 10, interval: time.Hour})
+	for _, data := range []string{"12345\n", "67890\n", "abc\n"} {
+		if err := w.write([]byte(data)); err != nil {
+			t.Fatalf("write error: %v", err)
+		}
+	}
+	if err := w.close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	names := listFiles(t, w.config.directory)
+	if len(names) != 2 {
+		t.Fatalf("expected the file is rotated by the size, but got %v", names)
+	}
+	var content []byte
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(w.config.directory, name))
+		if err != nil {
+			t.Fatalf("cannot read the file: %v", err)
+		}
+		content = append(content, data...)
+	}
+	if len(content) != len("12345\n67890\nabc\n") {
+		t.Errorf("unexpected content: %q", content)
+	}
+}
+
+func TestTimeRotation(t *testing.T) {
+	w := newWriter(t, &rotatingConfig{
+		prefix:      "pipe",
+		extension:   ".jsonl",
+		compression: compressionGzip,
+		maxSize:     1024,
+		interval:    50 * time.Millisecond,
+	})
+	if err := w.write([]byte("first\n")); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	// the file is rotated by the timer without the following writes.
+	time.Sleep(200 * time.Millisecond)
+	w.lock.Lock()
+	rotated := w.file == nil
+	w.lock.Unlock()
+	if !rotated {
+		t.Fatalf("expected the file is rotated by the interval")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if err := w.write([]byte("second\n")); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if err := w.close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	names := listFiles(t, w.config.directory)
+	if len(names) != 2 {
+		t.Fatalf("expected 2 files, but got %v", names)
+	}
+	for i, expected := range []string{"first\n", "second\n"} {
+		file, err := os.Open(filepath.Join(w.config.directory, names[i]))
+		if err != nil {
+			t.Fatalf("cannot open the file: %v", err)
+		}
+		reader, err := gzip.NewReader(file)
+		if err != nil {
+			t.Fatalf("cannot read the gzip file: %v", err)
+		}
+		data, err := io.ReadAll(reader)
+		file.Close()
+		if err != nil || !bytes.Equal(data, []byte(expected)) {
+			t.Errorf("expected the content %q of %s, but got %q, error: %v", expected, names[i], data, err)
+		}
+	}
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	w := newWriter(t, &rotatingConfig{prefix: "pipe", extension: ".jsonl", compression: compressionNone, maxSize: 1024, interval: time.Hour})
+	if err := w.write([]byte("data\n")); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if err := w.close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	if err := w.write([]byte("data\n")); err == nil {
+		t.Errorf("expected the error when writing the closed writer")
+	}
+}
+
+func TestConcurrentWrite(t *testing.T) {
+	w := newWriter(t, &rotatingConfig{prefix: "pipe", extension: ".jsonl", compression: compressionNone, maxSize: 64, interval: time.Hour})
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if err := w.write([]byte("0123456789\n")); err != nil {
+					t.Errorf("write error: %v", err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if err := w.close(); err != nil {
+		t.Fatalf("close error: %v", err)
+	}
+	var size int
+	for _, name := range listFiles(t, w.config.directory) {
+		data, err := os.ReadFile(filepath.Join(w.config.directory, name))
+		if err != nil {
+			t.Fatalf("cannot read the file: %v", err)
+		}
+		size += len(data)
+	}
+	if size != 80*len("0123456789\n") {
+		t.Errorf("expected all the concurrent writes are kept, but got %d bytes", size)
+	}
+}
@@ -20,11 +20,17 @@ package forwarder
 import (
 	"reflect"
 
+	debug "github.com/apache/skywalking-satellite/plugins/forwarder/debug"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	elasticsearch_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativetracing"
+	file_archive "github.com/apache/skywalking-satellite/plugins/forwarder/file/archive"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/envoyalsv2"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/envoyalsv3"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/envoymetricsv2"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/envoymetricsv3"
 	grpc_asyncprofiler "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeasyncprofiler"
+	grpc_nativebrowsererrorlog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativebrowsererrorlog"
+	grpc_nativebrowserperf "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativebrowserperf"
 	grpc_nativecds "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativecds"
 	grpc_nativeclr "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeclr"
 	grpc_nativeebpfaccesslog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeebpfaccesslog"
@@ -37,8 +43,26 @@ import (
 	grpc_nativeprocess "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeprocess"
 	grpc_nativeprofile "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeprofile"
 	grpc_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativetracing"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlplogsv1"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlpmetricsv1"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlptracesv1"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	http_zipkinv2 "github.com/apache/skywalking-satellite/plugins/forwarder/http/zipkinv2"
+	kafka_nativeclr "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeclr"
+	kafka_nativeevent "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeevent"
+	kafka_nativejvm "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativejvm"
 	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	kafka_nativemanagement "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemanagement"
+	kafka_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemeter"
+	kafka_nativeprofile "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeprofile"
+	kafka_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativetracing"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	otlp_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativemeter"
+	otlp_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativetracing"
+	prometheusremotewrite_envoymetricsv3 "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/envoymetricsv3"
+	prometheusremotewrite_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/nativemeter"
+	prometheusremotewrite_otlpmetricsv1 "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/otlpmetricsv1"
+	tcp_zabbixrelay "github.com/apache/skywalking-satellite/plugins/forwarder/tcp/zabbixrelay"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/api"
@@ -68,6 +92,30 @@ func RegisterForwarderPlugins() {
 		new(otlpmetricsv1.Forwarder),
 		new(grpc_nativeebpfaccesslog.Forwarder),
 		new(grpc_asyncprofiler.Forwarder),
+		new(http_zipkinv2.Forwarder),
+		new(otlptracesv1.Forwarder),
+		new(otlplogsv1.Forwarder),
+		new(grpc_nativebrowserperf.Forwarder),
+		new(grpc_nativebrowsererrorlog.Forwarder),
+		new(tcp_zabbixrelay.Forwarder),
+		new(kafka_nativetracing.Forwarder),
+		new(kafka_nativemeter.Forwarder),
+		new(kafka_nativejvm.Forwarder),
+		new(kafka_nativeclr.Forwarder),
+		new(kafka_nativemanagement.Forwarder),
+		new(kafka_nativeprofile.Forwarder),
+		new(kafka_nativeevent.Forwarder),
+		new(file_archive.Forwarder),
+		new(debug.Forwarder),
+		new(http_loki.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(elasticsearch_nativetracing.Forwarder),
+		new(prometheusremotewrite_nativemeter.Forwarder),
+		new(prometheusremotewrite_envoymetricsv3.Forwarder),
+		new(prometheusremotewrite_otlpmetricsv1.Forwarder),
+		new(otlp_nativetracing.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(otlp_nativemeter.Forwarder),
 	}
 	for _, forwarder := range forwarders {
 		plugin.RegisterPlugin(forwarder)
@@ -20,7 +20,6 @@ package envoyalsv3
 import (
 	"context"
 	"fmt"
-	"io"
 	"reflect"
 
 	"google.golang.org/grpc"
@@ -32,6 +31,7 @@ import (
 	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
 	"github.com/apache/skywalking-satellite/internal/satellite/telemetry"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/stream"
 	server_grpc "github.com/apache/skywalking-satellite/plugins/server/grpc"
 )
 
@@ -42,7 +42,10 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	stream.Config `mapstructure:",squash"`
+
 	alsClient v3.SatelliteAccessLogServiceClient
+	streams   *stream.Manager
 
 	eventReadySendCount        telemetry.Counter
 	eventSendFinishedCount     telemetry.Counter
@@ -78,7 +81,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return stream.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -89,60 +92,57 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 	}
 	f.alsClient = v3.NewSatelliteAccessLogServiceClient(client)
 	f.init()
+	f.streams = stream.NewManager(f.Name(), &f.Config, f.openStream, f.closeStream)
 	return nil
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
 	f.eventReadySendCount.Add(float64(len(batch)))
-	for _, e := range batch {
-		data, _ := e.GetData().(*v1.SniffData_EnvoyALSV3List)
-		f.streamingReadySendCount.Add(float64(len(data.EnvoyALSV3List.Messages)))
-	}
-
-	// open stream
-	timeRecord := f.forwardConnectTime.Start()
-	stream, err := f.alsClient.StreamAccessLogs(context.Background())
-	timeRecord.Stop()
-	if err != nil {
-		log.Logger.Errorf("open grpc stream error %v", err)
-		return err
-	}
-	peer := server_grpc.GetPeerHostFromStreamContext(stream.Context())
-	timeRecord = f.forwardSendTime.Start()
-
+	messages := make([]interface{}, 0)
+	events := 0
 	for _, e := range batch {
 		data := e.GetEnvoyALSV3List()
 		if data == nil {
 			continue
 		}
-
-		// send message
+		f.streamingReadySendCount.Add(float64(len(data.Messages)))
 		for _, message := range data.Messages {
-			err := stream.SendMsg(server_grpc.NewOriginalData(message))
-			if err != nil {
-				log.Logger.Errorf("%s send envoy ALS v3 data error: %v", f.Name(), err)
-				f.closeStream(stream)
-				return err
-			}
+			messages = append(messages, server_grpc.NewOriginalData(message))
 		}
-
-		f.eventSendFinishedCount.Inc(peer)
+		events++
 	}
 
+	timeRecord := f.forwardSendTime.Start()
+	peer, err := f.streams.Send(messages)
 	timeRecord.Stop()
-
-	// close stream
-	timeRecord = f.forwardCloseTime.Start()
-	f.closeStream(stream)
-	timeRecord.Stop()
+	if err != nil {
+		return err
+	}
+	f.eventSendFinishedCount.Add(float64(events), peer)
 	return nil
 }
 
-func (f *Forwarder) closeStream(stream v3.SatelliteAccessLogService_StreamAccessLogsClient) {
-	_, err := stream.CloseAndRecv()
-	if err != nil && err != io.EOF {
+func (f *Forwarder) openStream(ctx context.Context) (grpc.ClientStream, error) {
+	timeRecord := f.forwardConnectTime.Start()
+	defer timeRecord.Stop()
+	return f.alsClient.StreamAccessLogs(ctx)
+}
+
+func (f *Forwarder) closeStream(s grpc.ClientStream) error {
+	timeRecord := f.forwardCloseTime.Start()
+	defer timeRecord.Stop()
+	err := stream.Close(s)
+	// the response of the stream per batch is only logged, the reused streams fail their batches by it.
+	if err != nil && !f.ReuseStream {
 		log.Logger.Warnf("%s close stream error: %v", f.Name(), err)
+		return nil
 	}
+	return err
+}
+
+// Close closes the reused streams when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	return f.streams.Close()
 }
 
 func (f *Forwarder) ForwardType() v1.SniffType {
@@ -0,0 +1,102 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativebrowsererrorlog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/stream"
+	server_grpc "github.com/apache/skywalking-satellite/plugins/server/grpc"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-browser-error-log-grpc-forwarder"
+	ShowName = "Native Browser Error Log GRPC Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	stream.Config `mapstructure:",squash"`
+
+	client  agent.BrowserPerfServiceClient
+	streams *stream.Manager
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization grpc forwarder with the SkyWalking native browser error log protocol."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return stream.DefaultConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*grpc.ClientConn)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a grpc client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.client = agent.NewBrowserPerfServiceClient(client)
+	f.streams = stream.NewManager(f.Name(), &f.Config, func(ctx context.Context) (grpc.ClientStream, error) {
+		return f.client.CollectErrorLogs(ctx)
+	}, nil)
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	messages := make([]interface{}, 0, len(batch))
+	for _, e := range batch {
+		messages = append(messages, server_grpc.NewOriginalData(event.ExtensionData(e)))
+	}
+	_, err := f.streams.Send(messages)
+	return err
+}
+
+// Close closes the reused streams when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	return f.streams.Close()
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.BrowserErrorLogType
+}
+
+func (f *Forwarder) SyncForward(_ *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/unary"
 )
 
 const (
@@ -38,6 +39,7 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	unary.Config `mapstructure:",squash"`
 
 	client agent.JVMMetricReportServiceClient
 }
@@ -55,7 +57,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return unary.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -69,14 +71,10 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
-	for _, e := range batch {
-		jvm := e.GetJvm()
-		_, err := f.client.Collect(context.Background(), jvm)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return unary.Call(f.Concurrency, batch, func(e *v1.SniffData) error {
+		_, err := f.client.Collect(context.Background(), e.GetJvm())
+		return err
+	})
 }
 
 func (f *Forwarder) ForwardType() v1.SniffType {
@@ -31,6 +31,7 @@ import (
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/stream"
 	server_grpc "github.com/apache/skywalking-satellite/plugins/server/grpc"
 )
 
@@ -41,8 +42,10 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	stream.Config `mapstructure:",squash"`
 
 	logClient logging.LogReportServiceClient
+	streams   *stream.Manager
 }
 
 func (f *Forwarder) Name() string {
@@ -58,7 +61,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return stream.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -68,10 +71,33 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 			f.Name(), reflect.TypeOf(connection).String())
 	}
 	f.logClient = logging.NewLogReportServiceClient(client)
+	f.streams = stream.NewManager(f.Name(), &f.Config, func(ctx context.Context) (grpc.ClientStream, error) {
+		return f.logClient.Collect(ctx)
+	}, nil)
 	return nil
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	if !f.ReuseStream {
+		return f.forwardByLogList(batch)
+	}
+	// the whole batch is failed by the response of the reused stream, so it could be resent by the fallbacker.
+	messages := make([]interface{}, 0)
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_LogList)
+		if !ok {
+			continue
+		}
+		for _, logData := range data.LogList.Logs {
+			messages = append(messages, server_grpc.NewOriginalData(logData))
+		}
+	}
+	_, err := f.streams.Send(messages)
+	return err
+}
+
+// forwardByLogList sends every log list by its own stream, the log list failed to send is dropped after logged.
+func (f *Forwarder) forwardByLogList(batch event.BatchEvents) error {
 	for _, e := range batch {
 		data, ok := e.GetData().(*v1.SniffData_LogList)
 		if !ok {
@@ -103,12 +129,15 @@ func (f *Forwarder) Forward(batch event.BatchEvents) error {
 func (f *Forwarder) closeStream(stream logging.LogReportService_CollectClient) {
 	_, err := stream.CloseAndRecv()
 	if err != nil && err != io.EOF {
-		if err != nil {
-			log.Logger.Errorf("%s close stream error: %v", f.Name(), err)
-		}
+		log.Logger.Errorf("%s close stream error: %v", f.Name(), err)
 	}
 }
 
+// Close closes the reused streams when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	return f.streams.Close()
+}
+
 func (f *Forwarder) ForwardType() v1.SniffType {
 	return v1.SniffType_Logging
 }
@@ -29,6 +29,7 @@ import (
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/unary"
 )
 
 const (
@@ -38,6 +39,7 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	unary.Config `mapstructure:",squash"`
 
 	processClient process.EBPFProcessServiceClient
 }
@@ -55,7 +57,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return unary.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -69,17 +71,14 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
-	for _, e := range batch {
+	return unary.Call(f.Concurrency, batch, func(e *v1.SniffData) error {
 		data, ok := e.GetData().(*v1.SniffData_EBPFProcessPingPkgList)
 		if !ok {
-			continue
+			return nil
 		}
 		_, err := f.processClient.KeepAlive(context.Background(), data.EBPFProcessPingPkgList)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+		return err
+	})
 }
 
 func (f *Forwarder) ForwardType() v1.SniffType {
@@ -20,7 +20,6 @@ package nativeprofile
 import (
 	"context"
 	"fmt"
-	"io"
 	"reflect"
 
 	"google.golang.org/grpc"
@@ -29,8 +28,8 @@ import (
 	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
-	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/stream"
 )
 
 const (
@@ -40,8 +39,10 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	stream.Config `mapstructure:",squash"`
 
 	profileClient profile.ProfileTaskClient
+	streams       *stream.Manager
 }
 
 func (f *Forwarder) Name() string {
@@ -57,7 +58,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return stream.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -67,39 +68,28 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 			f.Name(), reflect.TypeOf(connection).String())
 	}
 	f.profileClient = profile.NewProfileTaskClient(client)
+	f.streams = stream.NewManager(f.Name(), &f.Config, func(ctx context.Context) (grpc.ClientStream, error) {
+		return f.profileClient.CollectSnapshot(ctx)
+	}, nil)
 	return nil
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
-	stream, err := f.profileClient.CollectSnapshot(context.Background())
-	if err != nil {
-		log.Logger.Errorf("open grpc stream error %v", err)
-		return err
-	}
+	messages := make([]interface{}, 0, len(batch))
 	for _, e := range batch {
 		data, ok := e.GetData().(*v1.SniffData_Profile)
 		if !ok {
 			continue
 		}
-		err := stream.Send(data.Profile)
-		if err != nil {
-			log.Logger.Errorf("%s send log data error: %v", f.Name(), err)
-			err = closeStream(stream)
-			if err != nil {
-				log.Logger.Errorf("%s close stream error: %v", f.Name(), err)
-			}
-			return err
-		}
+		messages = append(messages, data.Profile)
 	}
-	return closeStream(stream)
+	_, err := f.streams.Send(messages)
+	return err
 }
 
-func closeStream(stream profile.ProfileTask_CollectSnapshotClient) error {
-	_, err := stream.CloseAndRecv()
-	if err != nil && err != io.EOF {
-		return err
-	}
-	return nil
+// Close closes the reused streams when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	return f.streams.Close()
 }
 
 func (f *Forwarder) ForwardType() v1.SniffType {
@@ -20,19 +20,15 @@ package nativetracing
 import (
 	"context"
 	"fmt"
-	"io"
 	"reflect"
 
-	"github.com/hashicorp/go-multierror"
-
 	"google.golang.org/grpc"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
-	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/stream"
 	server_grpc "github.com/apache/skywalking-satellite/plugins/server/grpc"
 
-	v3 "skywalking.apache.org/repo/goapi/collect/common/v3"
 	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
 	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
 )
@@ -44,14 +40,12 @@ const (
 
 type Forwarder struct {
 	config.CommonFields
+	stream.Config `mapstructure:",squash"`
 
-	tracingClient       agent.TraceSegmentReportServiceClient
-	attachedEventClient agent.SpanAttachedEventReportServiceClient
-}
-
-type streaming interface {
-	SendMsg(m interface{}) error
-	CloseAndRecv() (*v3.Commands, error)
+	tracingClient        agent.TraceSegmentReportServiceClient
+	attachedEventClient  agent.SpanAttachedEventReportServiceClient
+	tracingStreams       *stream.Manager
+	attachedEventStreams *stream.Manager
 }
 
 func (f *Forwarder) Name() string {
@@ -67,7 +61,7 @@ func (f *Forwarder) Description() string {
 }
 
 func (f *Forwarder) DefaultConfig() string {
-	return ``
+	return stream.DefaultConfig
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
@@ -78,67 +72,39 @@ func (f *Forwarder) Prepare(connection interface{}) error {
 	}
 	f.tracingClient = agent.NewTraceSegmentReportServiceClient(client)
 	f.attachedEventClient = agent.NewSpanAttachedEventReportServiceClient(client)
+	f.tracingStreams = stream.NewManager(f.Name(), &f.Config, func(ctx context.Context) (grpc.ClientStream, error) {
+		return f.tracingClient.Collect(ctx)
+	}, nil)
+	f.attachedEventStreams = stream.NewManager(f.Name(), &f.Config, func(ctx context.Context) (grpc.ClientStream, error) {
+		return f.attachedEventClient.Collect(ctx)
+	}, nil)
 	return nil
 }
 
 func (f *Forwarder) Forward(batch event.BatchEvents) error {
-	var tracingStream agent.TraceSegmentReportService_CollectClient
-	var spanStream agent.SpanAttachedEventReportService_CollectClient
-
-	defer func() {
-		if err := closeStream(tracingStream, spanStream); err != nil {
-			log.Logger.Errorf("%s close stream error: %v", f.Name(), err)
-		}
-	}()
-	var err error
-	var stream streaming
-	var streamData *server_grpc.OriginalData
+	segments := make([]interface{}, 0, len(batch))
+	attachedEvents := make([]interface{}, 0)
 	for _, e := range batch {
 		switch data := e.GetData().(type) {
 		case *v1.SniffData_Segment:
-			if tracingStream == nil {
-				tracingStream, err = f.tracingClient.Collect(context.Background())
-				if err != nil {
-					log.Logger.Errorf("open grpc stream error %v", err)
-					return err
-				}
-			}
-			stream = tracingStream
-			streamData = server_grpc.NewOriginalData(data.Segment)
+			segments = append(segments, server_grpc.NewOriginalData(data.Segment))
 		case *v1.SniffData_SpanAttachedEvent:
-			if spanStream == nil {
-				spanStream, err = f.attachedEventClient.Collect(context.Background())
-				if err != nil {
-					log.Logger.Errorf("open grpc stream error %v", err)
-					return err
-				}
-			}
-			stream = spanStream
-			streamData = server_grpc.NewOriginalData(data.SpanAttachedEvent)
-		default:
-			continue
-		}
-
-		err = stream.SendMsg(streamData)
-		if err != nil {
-			log.Logger.Errorf("%s send log data error: %v", f.Name(), err)
-			return err
+			attachedEvents = append(attachedEvents, server_grpc.NewOriginalData(data.SpanAttachedEvent))
 		}
 	}
-	return closeStream(tracingStream, spanStream)
+	if _, err := f.tracingStreams.Send(segments); err != nil {
+		return err
+	}
+	_, err := f.attachedEventStreams.Send(attachedEvents)
+	return err
 }
 
-func closeStream(streams ...streaming) error {
-	var err error
-	for _, s := range streams {
-		if s == nil {
-			continue
-		}
-		if _, e := s.CloseAndRecv(); e != nil && e != io.EOF {
-			err = multierror.Append(err, e)
-		}
+// Close closes the reused streams when the pipe is shutting down.
+func (f *Forwarder) Close() error {
+	if err := f.tracingStreams.Close(); err != nil {
+		return err
 	}
-	return err
+	return f.attachedEventStreams.Close()
 }
 
 func (f *Forwarder) ForwardType() v1.SniffType {
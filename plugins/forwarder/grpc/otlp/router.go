@@ -0,0 +1,121 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/plugins/client/grpc/lb"
+)
+
+// Router routes the OpenTelemetry traces and logs having the same resource attributes to the same upstream,
+// the upstream of the routing key is cached when the request is exported. The routing key is generated
+// like the otlpmetricsv1 forwarder, but the requests without the routing key are not dropped.
+type Router struct {
+	name        string
+	keys        []string
+	cache       *cache.LRUExpireCache
+	cacheExpire time.Duration
+}
+
+// NewRouter creates a Router of the forwarder, the routing label keys are split by ",".
+func NewRouter(name, routingLabelKeys string, cacheSize, cacheTTL int) (*Router, error) {
+	if routingLabelKeys == "" {
+		return nil, fmt.Errorf("please provide metadata keys")
+	}
+	return &Router{
+		name:        name,
+		keys:        strings.Split(routingLabelKeys, ","),
+		cache:       cache.NewLRUExpireCache(cacheSize),
+		cacheExpire: time.Second * time.Duration(cacheTTL),
+	}, nil
+}
+
+// Export exports the request by the routing key of the resources. The request is still exported
+// without the load balance routing when the resources do not have any routing label.
+func (r *Router) Export(resources []*resource.Resource, export func(ctx context.Context) error) error {
+	key, err := r.generateRoutingKey(resources)
+	if err != nil {
+		log.Logger.Warnf("%s generate the routing key failure, send the data without routing: %v", r.name, err)
+		return export(context.Background())
+	}
+	ctx := lb.WithLoadBalanceConfig(context.Background(), key, r.loadCachedPeer(key))
+	if err := export(ctx); err != nil {
+		return err
+	}
+	r.savePeer(ctx, key)
+	return nil
+}
+
+func (r *Router) savePeer(ctx context.Context, key string) {
+	upstream := lb.GetAddress(ctx)
+	if upstream == "" {
+		return
+	}
+	r.cache.Add(key, upstream, r.cacheExpire)
+}
+
+func (r *Router) loadCachedPeer(key string) string {
+	if get, exists := r.cache.Get(key); exists {
+		return get.(string)
+	}
+	return ""
+}
+
+func (r *Router) generateRoutingKey(resources []*resource.Resource) (string, error) {
+	if len(resources) == 0 {
+		return "", fmt.Errorf("no resources")
+	}
+	var lastResource *resource.Resource
+	for _, res := range resources {
+		if len(res.GetAttributes()) == 0 {
+			continue
+		}
+		lastResource = res
+		result := ""
+		for _, kv := range res.GetAttributes() {
+			for _, key := range r.keys {
+				if kv.GetKey() == key {
+					result += fmt.Sprintf(",%s", kv.GetValue().GetStringValue())
+				}
+			}
+		}
+		if result != "" {
+			return result, nil
+		}
+	}
+	if lastResource == nil {
+		return "", fmt.Errorf("could not found any attributes")
+	}
+
+	var keys string
+	for i, k := range lastResource.GetAttributes() {
+		if i > 0 {
+			keys += ","
+		}
+		keys += k.GetKey()
+	}
+	return "", fmt.Errorf("could not found any routing key, existing keys sample: %s", keys)
+}
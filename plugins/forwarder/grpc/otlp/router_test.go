@@ -0,0 +1,81 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"context"
+	"testing"
+
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+func newResource(kvs ...string) *resource.Resource {
+	res := &resource.Resource{}
+	for i := 0; i+1 < len(kvs); i += 2 {
+		res.Attributes = append(res.Attributes, &common.KeyValue{
+			Key:   kvs[i],
+			Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: kvs[i+1]}},
+		})
+	}
+	return res
+}
+
+func TestRoutingKey(t *testing.T) {
+	router, err := NewRouter("test", "service.name,host.name", 10, 60)
+	if err != nil {
+		t.Fatalf("cannot create the router: %v", err)
+	}
+	tests := []struct {
+		name      string
+		resources []*resource.Resource
+		key       string
+	}{
+		{name: "no resources"},
+		{name: "no attributes", resources: []*resource.Resource{nil, newResource()}},
+		{name: "no routing label", resources: []*resource.Resource{newResource("job", "a")}},
+		{name: "routing labels", resources: []*resource.Resource{newResource("service.name", "svc", "host.name", "host")}, key: ",svc,host"},
+		{name: "first routed resource", resources: []*resource.Resource{newResource("job", "a"), newResource("host.name", "host")}, key: ",host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := router.generateRoutingKey(tt.resources)
+			if key != tt.key || (err == nil) != (tt.key != "") {
+				t.Errorf("expected the routing key %q, but got %q, error: %v", tt.key, key, err)
+			}
+		})
+	}
+}
+
+func TestExportWithoutRoutingKey(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	router, err := NewRouter("test", "service.name", 10, 60)
+	if err != nil {
+		t.Fatalf("cannot create the router: %v", err)
+	}
+	exported := 0
+	err = router.Export([]*resource.Resource{newResource("job", "a")}, func(ctx context.Context) error {
+		exported++
+		return nil
+	})
+	if err != nil || exported != 1 {
+		t.Errorf("expected the request is exported without routing, but exported %d times, error: %v", exported, err)
+	}
+}
@@ -0,0 +1,128 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlptracesv1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlp"
+
+	trace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	Name     = "otlp-traces-v1-grpc-forwarder"
+	ShowName = "OpenTelemetry Traces v1 GRPC Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	// The label key of the routing data, multiple keys are split by ","
+	RoutingLabelKeys string `mapstructure:"routing_label_keys"`
+	// The LRU policy cache size for hosting routine rules of service instance.
+	RoutingRuleLRUCacheSize int `mapstructure:"routing_rule_lru_cache_size"`
+	// The TTL of the LRU cache size for hosting routine rules of service instance.
+	RoutingRuleLRUCacheTTL int `mapstructure:"routing_rule_lru_cache_ttl"`
+
+	traceClient trace.TraceServiceClient
+	router      *otlp.Router
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization grpc forwarder with the OpenTelemetry traces v1 protocol."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The LRU policy cache size for hosting routine rules of service instance.
+routing_rule_lru_cache_size: 5000
+# The TTL of the LRU cache size for hosting routine rules of service instance.
+routing_rule_lru_cache_ttl: 180
+# The label key of the routing data, multiple keys are split by ","
+routing_label_keys: service.name,service.instance.id,host.name
+`
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*grpc.ClientConn)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a grpc client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.traceClient = trace.NewTraceServiceClient(client)
+	router, err := otlp.NewRouter(f.Name(), f.RoutingLabelKeys, f.RoutingRuleLRUCacheSize, f.RoutingRuleLRUCacheTTL)
+	if err != nil {
+		return err
+	}
+	f.router = router
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	for _, d := range batch {
+		req := &trace.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(event.ExtensionData(d), req); err != nil {
+			log.Logger.Errorf("%s unmarshal the trace data failure: %v", f.Name(), err)
+			continue
+		}
+		resources := make([]*resource.Resource, 0, len(req.GetResourceSpans()))
+		for _, resourceSpans := range req.GetResourceSpans() {
+			resources = append(resources, resourceSpans.GetResource())
+		}
+		err := f.router.Export(resources, func(ctx context.Context) error {
+			_, err := f.traceClient.Export(ctx, req)
+			return err
+		})
+		if err != nil {
+			log.Logger.Errorf("%s send trace data error: %v", f.Name(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.OpenTelemetryTracesV1Type
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
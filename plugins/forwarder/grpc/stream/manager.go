@@ -0,0 +1,263 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stream
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	server_grpc "github.com/apache/skywalking-satellite/plugins/server/grpc"
+)
+
+// defaultStreamMaxAge bounds the waiting of the batches when the max age of the reused streams is not set.
+var defaultStreamMaxAge = 10
+
+// Config is the common config of the gRPC forwarders sending by the client streams.
+type Config struct {
+	ReuseStream       bool `mapstructure:"reuse_stream"`        // Keep the streams open between the batches.
+	StreamPoolSize    int  `mapstructure:"stream_pool_size"`    // The count of the reused streams.
+	StreamMaxAge      int  `mapstructure:"stream_max_age"`      // The max age of a reused stream, the time unit is second.
+	StreamMaxMessages int  `mapstructure:"stream_max_messages"` // The max messages of a reused stream.
+}
+
+// RequiresConcurrentBatches returns true when the streams are reused, as a batch is finished by the response of its stream.
+func (c *Config) RequiresConcurrentBatches() bool {
+	return c.ReuseStream
+}
+
+// DefaultConfig is the default config of the Config.
+const DefaultConfig = `
+# Share the streams between the batches instead of opening a stream for every batch.
+# A batch is finished after the response of its stream, which is received when the stream is closed by the max age or
+# the max messages, so the reused streams require the max_inflight_batches of the sender greater than 1,
+# and they are not supported by the mirrors and the replay command.
+reuse_stream: false
+# The count of the reused streams, the concurrent batches are sent by the different streams.
+# The upstream of a stream is picked when it is opened, so the traffic is only rebalanced when the streams are reopened
+# after the max age or the max messages.
+stream_pool_size: 4
+# The max age of a reused stream, it is closed after it, and the time unit is second.
+stream_max_age: 10
+# The max messages of a reused stream, it is closed after it, 0 means unlimited.
+stream_max_messages: 10000
+`
+
+// Opener opens a client stream of the forwarder.
+type Opener func(ctx context.Context) (grpc.ClientStream, error)
+
+// Closer half-closes a client stream and waits for the response of the upstream.
+type Closer func(stream grpc.ClientStream) error
+
+// Manager sends the messages by the client streams. When the streams are reused, the batches are finished by
+// the response of the stream, so a failure of the upstream is returned to all the batches sent by the stream.
+type Manager struct {
+	name   string
+	config *Config
+	open   Opener
+	close  Closer
+
+	slots []*slot
+	next  uint32
+}
+
+type slot struct {
+	lock    sync.Mutex
+	current *pooledStream
+}
+
+// pooledStream is a reused stream, the done channel is closed when the response of the stream is received.
+type pooledStream struct {
+	stream   grpc.ClientStream
+	cancel   context.CancelFunc
+	timer    *time.Timer
+	messages int
+	done     chan struct{}
+	err      error
+	once     sync.Once
+}
+
+// NewManager creates a Manager of the forwarder, the streams are closed by the Close when the closer is nil.
+func NewManager(name string, config *Config, open Opener, closer Closer) *Manager {
+	if closer == nil {
+		closer = Close
+	}
+	size := config.StreamPoolSize
+	if size <= 0 {
+		size = 1
+	}
+	slots := make([]*slot, size)
+	for i := range slots {
+		slots[i] = &slot{}
+	}
+	return &Manager{name: name, config: config, open: open, close: closer, slots: slots}
+}
+
+// Send sends the messages by a stream and returns the peer host of the stream after the response of the stream.
+// When the streams are not reused, a stream is opened for the messages and closed after them.
+func (m *Manager) Send(messages []interface{}) (string, error) {
+	if len(messages) == 0 {
+		return "", nil
+	}
+	if !m.config.ReuseStream {
+		return m.sendOnce(messages)
+	}
+	s := m.slots[atomic.AddUint32(&m.next, 1)%uint32(len(m.slots))] // #nosec G115
+	s.lock.Lock()
+	ps, err := m.sendBySlot(s, messages)
+	if err != nil {
+		s.lock.Unlock()
+		return "", err
+	}
+	expired := m.config.StreamMaxMessages > 0 && ps.messages >= m.config.StreamMaxMessages
+	if expired {
+		s.current = nil
+	}
+	s.lock.Unlock()
+	if expired {
+		m.finish(ps)
+	}
+	<-ps.done
+	return server_grpc.GetPeerHostFromStreamContext(ps.stream.Context()), ps.err
+}
+
+// Close closes the reused streams and waits for their responses, the waiting batches are finished by the responses.
+func (m *Manager) Close() error {
+	var err error
+	for _, s := range m.slots {
+		s.lock.Lock()
+		ps := s.current
+		s.current = nil
+		s.lock.Unlock()
+		if ps == nil {
+			continue
+		}
+		m.finish(ps)
+		if ps.err != nil {
+			err = multierror.Append(err, ps.err)
+		}
+	}
+	return err
+}
+
+func (m *Manager) sendOnce(messages []interface{}) (string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := m.open(ctx)
+	if err != nil {
+		log.Logger.Errorf("open grpc stream error %v", err)
+		return "", err
+	}
+	peer := server_grpc.GetPeerHostFromStreamContext(stream.Context())
+	for _, message := range messages {
+		if err := stream.SendMsg(message); err != nil {
+			log.Logger.Errorf("%s send data error: %v", m.name, err)
+			if closeErr := m.close(stream); closeErr != nil {
+				return peer, closeErr
+			}
+			return peer, err
+		}
+	}
+	return peer, m.close(stream)
+}
+
+// sendBySlot sends the messages by the current stream of the slot, and returns the stream to wait for the response.
+func (m *Manager) sendBySlot(s *slot, messages []interface{}) (*pooledStream, error) {
+	opened := false
+	for i := 0; i < len(messages); {
+		if s.current == nil {
+			ps, err := m.openStream(s)
+			if err != nil {
+				log.Logger.Errorf("open grpc stream error %v", err)
+				return nil, err
+			}
+			s.current, opened = ps, true
+		}
+		ps := s.current
+		err := ps.stream.SendMsg(messages[i])
+		if err == nil {
+			ps.messages++
+			i++
+			continue
+		}
+		// the real status of the stream is received by closing it, and the previous batches of the stream are failed by it.
+		s.current = nil
+		m.finish(ps)
+		if ps.err != nil {
+			err = ps.err
+		}
+		// the reused stream could be broken before this batch, so the batch is sent by a new stream.
+		if !opened && i == 0 {
+			log.Logger.Warnf("%s the reused stream is broken, reopen it: %v", m.name, err)
+			continue
+		}
+		log.Logger.Errorf("%s send data error: %v", m.name, err)
+		return nil, err
+	}
+	return s.current, nil
+}
+
+func (m *Manager) openStream(s *slot) (*pooledStream, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := m.open(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	ps := &pooledStream{stream: stream, cancel: cancel, done: make(chan struct{})}
+	maxAge := m.config.StreamMaxAge
+	if maxAge <= 0 {
+		maxAge = defaultStreamMaxAge
+	}
+	ps.timer = time.AfterFunc(time.Duration(maxAge)*time.Second, func() {
+		s.lock.Lock()
+		if s.current == ps {
+			s.current = nil
+		}
+		s.lock.Unlock()
+		m.finish(ps)
+	})
+	return ps, nil
+}
+
+// finish closes the stream and notifies the waiting batches, the stream must be removed from the slot before.
+func (m *Manager) finish(ps *pooledStream) {
+	ps.once.Do(func() {
+		ps.timer.Stop()
+		ps.err = m.close(ps.stream)
+		ps.cancel()
+		close(ps.done)
+	})
+}
+
+// Close half-closes the client stream and receives the response of the upstream.
+func Close(stream grpc.ClientStream) error {
+	if err := stream.CloseSend(); err != nil && err != io.EOF {
+		return err
+	}
+	if err := stream.RecvMsg(server_grpc.NewOriginalData(nil)); err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
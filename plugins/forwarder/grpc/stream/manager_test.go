@@ -0,0 +1,235 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+func init() {
+	log.Init(&log.LoggerConfig{})
+}
+
+type fakeStream struct {
+	ctx     context.Context
+	lock    sync.Mutex
+	sent    int
+	closed  bool
+	broken  bool
+	respErr error
+}
+
+func (s *fakeStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *fakeStream) Trailer() metadata.MD         { return nil }
+func (s *fakeStream) Context() context.Context     { return s.ctx }
+
+func (s *fakeStream) CloseSend() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeStream) SendMsg(_ interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.broken || s.closed {
+		return io.EOF
+	}
+	s.sent++
+	return nil
+}
+
+func (s *fakeStream) RecvMsg(_ interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.broken {
+		return errors.New("stream broken")
+	}
+	return s.respErr
+}
+
+func (s *fakeStream) state() (sent int, closed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.sent, s.closed
+}
+
+type fakeOpener struct {
+	lock    sync.Mutex
+	streams []*fakeStream
+	respErr error
+}
+
+func (o *fakeOpener) open(ctx context.Context) (grpc.ClientStream, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	s := &fakeStream{ctx: ctx, respErr: o.respErr}
+	o.streams = append(o.streams, s)
+	return s, nil
+}
+
+func (o *fakeOpener) stream(i int) *fakeStream {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if i >= len(o.streams) {
+		return nil
+	}
+	return o.streams[i]
+}
+
+func (o *fakeOpener) count() int {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	return len(o.streams)
+}
+
+func messages(count int) []interface{} {
+	return make([]interface{}, count)
+}
+
+// sendAsync sends the messages by a new routine, and returns the result channel.
+func sendAsync(m *Manager, count int) chan error {
+	result := make(chan error, 1)
+	go func() {
+		_, err := m.Send(messages(count))
+		result <- err
+	}()
+	return result
+}
+
+func waitSent(t *testing.T, s func() *fakeStream, sent int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if stream := s(); stream != nil {
+			if current, _ := stream.state(); current >= sent {
+				return
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("the messages are not sent")
+}
+
+func waitResult(t *testing.T, result chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(time.Second):
+		t.Fatalf("the batch is not finished")
+		return nil
+	}
+}
+
+func TestReuseStreamWaitsForResponse(t *testing.T) {
+	opener := &fakeOpener{}
+	m := NewManager("test", &Config{ReuseStream: true, StreamPoolSize: 1, StreamMaxMessages: 4, StreamMaxAge: 60}, opener.open, nil)
+	first := sendAsync(m, 2)
+	waitSent(t, func() *fakeStream { return opener.stream(0) }, 2)
+	select {
+	case <-first:
+		t.Fatalf("the batch should wait for the response of the stream")
+	case <-time.After(50 * time.Millisecond):
+	}
+	// the stream is closed when reaching the max messages, and the both batches are finished.
+	if err := waitResult(t, sendAsync(m, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitResult(t, first); err != nil {
+		t.Fatal(err)
+	}
+	if sent, closed := opener.stream(0).state(); !closed || sent != 4 {
+		t.Fatalf("the stream should be closed after 4 messages, sent %d", sent)
+	}
+
+	// the waiting batch is finished by closing the manager.
+	third := sendAsync(m, 1)
+	waitSent(t, func() *fakeStream { return opener.stream(1) }, 1)
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitResult(t, third); err != nil {
+		t.Fatal(err)
+	}
+	if opener.count() != 2 {
+		t.Fatalf("expected 2 streams, but got %d", opener.count())
+	}
+}
+
+func TestReuseStreamReturnsResponseError(t *testing.T) {
+	opener := &fakeOpener{respErr: errors.New("unavailable")}
+	m := NewManager("test", &Config{ReuseStream: true, StreamPoolSize: 1, StreamMaxMessages: 2, StreamMaxAge: 60}, opener.open, nil)
+	if _, err := m.Send(messages(2)); err == nil {
+		t.Fatalf("the error of the response should be returned")
+	}
+}
+
+func TestReopenBrokenStream(t *testing.T) {
+	opener := &fakeOpener{}
+	m := NewManager("test", &Config{ReuseStream: true, StreamPoolSize: 1, StreamMaxAge: 60}, opener.open, nil)
+	first := sendAsync(m, 1)
+	waitSent(t, func() *fakeStream { return opener.stream(0) }, 1)
+	broken := opener.stream(0)
+	broken.lock.Lock()
+	broken.broken = true
+	broken.lock.Unlock()
+
+	// the batch sent by the broken stream is failed, and the next batch is sent by a new stream.
+	second := sendAsync(m, 2)
+	if err := waitResult(t, first); err == nil {
+		t.Fatalf("the batch of the broken stream should be failed")
+	}
+	waitSent(t, func() *fakeStream { return opener.stream(1) }, 2)
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitResult(t, second); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStreamPerBatch(t *testing.T) {
+	opener := &fakeOpener{}
+	m := NewManager("test", &Config{}, opener.open, nil)
+	for i := 0; i < 3; i++ {
+		if _, err := m.Send(messages(2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Send(nil); err != nil {
+		t.Fatal(err)
+	}
+	if opener.count() != 3 {
+		t.Fatalf("expected 3 streams, but got %d", opener.count())
+	}
+	for i := 0; i < 3; i++ {
+		if sent, closed := opener.stream(i).state(); !closed || sent != 2 {
+			t.Fatalf("every stream should be closed after the batch")
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unary
+
+import (
+	"sync"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// Config is the common config of the gRPC forwarders sending an event by a unary call.
+type Config struct {
+	Concurrency int `mapstructure:"concurrency"` // The max parallel calls of a batch.
+}
+
+// DefaultConfig is the default config of the Config.
+const DefaultConfig = `
+# The max parallel calls of a batch, the events are sent one by one when it is 1.
+# The parallel calls improve the throughput when the latency to the upstream is high.
+concurrency: 1
+`
+
+// Call invokes the call for every event of the batch with the max concurrency, and returns the first error.
+// No new call is started after an error.
+func Call(concurrency int, batch event.BatchEvents, call func(e *v1.SniffData) error) error {
+	if concurrency <= 1 {
+		for _, e := range batch {
+			if err := call(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var firstErr error
+	failed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return firstErr != nil
+	}
+	slots := make(chan struct{}, concurrency)
+	for _, e := range batch {
+		slots <- struct{}{}
+		if failed() {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(e *v1.SniffData) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := call(e); err != nil {
+				lock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				lock.Unlock()
+			}
+		}(e)
+	}
+	wg.Wait()
+	return firstErr
+}
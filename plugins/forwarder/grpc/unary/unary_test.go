@@ -0,0 +1,80 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package unary
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+func TestCall(t *testing.T) {
+	batch := make(event.BatchEvents, 20)
+	for i := range batch {
+		batch[i] = &v1.SniffData{}
+	}
+	var calls, running, maxRunning int32
+	err := Call(4, batch, func(_ *v1.SniffData) error {
+		atomic.AddInt32(&calls, 1)
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 20 {
+		t.Fatalf("expected 20 calls, but got %d", calls)
+	}
+	if maxRunning < 2 || maxRunning > 4 {
+		t.Fatalf("expected 2 to 4 parallel calls, but got %d", maxRunning)
+	}
+}
+
+func TestCallError(t *testing.T) {
+	batch := make(event.BatchEvents, 20)
+	for i := range batch {
+		batch[i] = &v1.SniffData{}
+	}
+	for _, concurrency := range []int{1, 4} {
+		var calls int32
+		err := Call(concurrency, batch, func(_ *v1.SniffData) error {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(time.Millisecond)
+			return errors.New("unavailable")
+		})
+		if err == nil {
+			t.Fatalf("the error should be returned")
+		}
+		if calls > int32(concurrency) {
+			t.Fatalf("no call should be started after the error, but got %d calls", calls)
+		}
+	}
+}
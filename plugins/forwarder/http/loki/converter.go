@@ -0,0 +1,179 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package loki
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/loki/logproto"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+const (
+	levelLabel     = "level"
+	unknownService = "unknown"
+)
+
+// labelMapping decides the stream labels and the structured metadata of the native logs.
+type labelMapping struct {
+	serviceLabel       string
+	instanceLabel      string
+	levelTag           string
+	tagLabels          map[string]string
+	staticLabels       map[string]string
+	structuredMetadata bool
+}
+
+// stream is the logs having the same labels.
+type stream struct {
+	labels  map[string]string
+	entries []*logproto.EntryAdapter
+}
+
+// convertToStreams groups the native logs into the Loki streams, the streams are sorted by the labels.
+func convertToStreams(logs []*logging.LogData, mapping *labelMapping) []*stream {
+	streams := make(map[string]*stream)
+	for _, l := range logs {
+		labels, metadata := mapping.mapTags(l)
+		key := labelsString(labels)
+		s, ok := streams[key]
+		if !ok {
+			s = &stream{labels: labels}
+			streams[key] = s
+		}
+		s.entries = append(s.entries, &logproto.EntryAdapter{
+			Timestamp:          timestamppb.New(logTime(l)),
+			Line:               logLine(l),
+			StructuredMetadata: metadata,
+		})
+	}
+	keys := make([]string, 0, len(streams))
+	for k := range streams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	result := make([]*stream, 0, len(keys))
+	for _, k := range keys {
+		s := streams[k]
+		sort.SliceStable(s.entries, func(i, j int) bool {
+			return s.entries[i].GetTimestamp().AsTime().Before(s.entries[j].GetTimestamp().AsTime())
+		})
+		result = append(result, s)
+	}
+	return result
+}
+
+func (m *labelMapping) mapTags(l *logging.LogData) (labels map[string]string, metadata []*logproto.LabelPairAdapter) {
+	labels = make(map[string]string, len(m.staticLabels)+len(m.tagLabels)+3)
+	for k, v := range m.staticLabels {
+		labels[k] = v
+	}
+	// Loki rejects the streams without any label, so the service label is always present.
+	labels[m.serviceLabel] = unknownService
+	if l.GetService() != "" {
+		labels[m.serviceLabel] = l.GetService()
+	}
+	if m.instanceLabel != "" && l.GetServiceInstance() != "" {
+		labels[m.instanceLabel] = l.GetServiceInstance()
+	}
+	if m.structuredMetadata {
+		metadata = appendMetadata(metadata, "trace_id", l.GetTraceContext().GetTraceId())
+		metadata = appendMetadata(metadata, "segment_id", l.GetTraceContext().GetTraceSegmentId())
+		if l.GetTraceContext().GetTraceId() != "" {
+			metadata = appendMetadata(metadata, "span_id", strconv.Itoa(int(l.GetTraceContext().GetSpanId())))
+		}
+		metadata = appendMetadata(metadata, "endpoint", l.GetEndpoint())
+	}
+	for _, tag := range l.GetTags().GetData() {
+		if tag.GetKey() == m.levelTag {
+			labels[levelLabel] = tag.GetValue()
+		} else if name, ok := m.tagLabels[tag.GetKey()]; ok {
+			labels[name] = tag.GetValue()
+		} else if m.structuredMetadata {
+			metadata = appendMetadata(metadata, sanitizeLabelName(tag.GetKey()), tag.GetValue())
+		}
+	}
+	return labels, metadata
+}
+
+func appendMetadata(metadata []*logproto.LabelPairAdapter, name, value string) []*logproto.LabelPairAdapter {
+	if value == "" {
+		return metadata
+	}
+	return append(metadata, &logproto.LabelPairAdapter{Name: name, Value: value})
+}
+
+func logTime(l *logging.LogData) time.Time {
+	if l.GetTimestamp() == 0 {
+		return time.Now()
+	}
+	return time.UnixMilli(l.GetTimestamp())
+}
+
+func logLine(l *logging.LogData) string {
+	body := l.GetBody()
+	switch {
+	case body.GetText() != nil:
+		return body.GetText().GetText()
+	case body.GetJson() != nil:
+		return body.GetJson().GetJson()
+	case body.GetYaml() != nil:
+		return body.GetYaml().GetYaml()
+	default:
+		return ""
+	}
+}
+
+// labelsString builds the labels in the Prometheus text format with the sorted names.
+func labelsString(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(name)
+		sb.WriteByte('=')
+		sb.WriteString(strconv.Quote(labels[name]))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// sanitizeLabelName replaces the characters not allowed in the label names by the underscore.
+func sanitizeLabelName(name string) string {
+	result := []byte(name)
+	for i, c := range result {
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		result[i] = '_'
+	}
+	return string(result)
+}
@@ -0,0 +1,112 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package loki
+
+import (
+	"reflect"
+	"testing"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+func newLog(service string, timestamp int64, line string, tags ...*common.KeyStringValuePair) *logging.LogData {
+	return &logging.LogData{
+		Service:   service,
+		Timestamp: timestamp,
+		Body:      &logging.LogDataBody{Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: line}}},
+		Tags:      &logging.LogTags{Data: tags},
+	}
+}
+
+func TestConvertToStreams(t *testing.T) {
+	mapping := &labelMapping{
+		serviceLabel:  "service_name",
+		instanceLabel: "service_instance",
+		levelTag:      "level",
+		tagLabels:     map[string]string{"http.method": "http_method"},
+		staticLabels:  map[string]string{"cluster": "c1"},
+	}
+	logs := []*logging.LogData{
+		newLog("svc-b", 2000, "b2"),
+		newLog("svc-a", 3000, "a3", &common.KeyStringValuePair{Key: "level", Value: "ERROR"}),
+		newLog("svc-b", 1000, "b1"),
+		newLog("", 1000, "unknown", &common.KeyStringValuePair{Key: "http.method", Value: "GET"}),
+	}
+	logs[0].ServiceInstance = "ins-b"
+	logs[2].ServiceInstance = "ins-b"
+
+	streams := convertToStreams(logs, mapping)
+	expected := []struct {
+		labels string
+		lines  []string
+	}{
+		{labels: `{cluster="c1", http_method="GET", service_name="unknown"}`, lines: []string{"unknown"}},
+		{labels: `{cluster="c1", level="ERROR", service_name="svc-a"}`, lines: []string{"a3"}},
+		{labels: `{cluster="c1", service_instance="ins-b", service_name="svc-b"}`, lines: []string{"b1", "b2"}},
+	}
+	if len(streams) != len(expected) {
+		t.Fatalf("expected %d streams, but got %d", len(expected), len(streams))
+	}
+	for i, s := range streams {
+		if labels := labelsString(s.labels); labels != expected[i].labels {
+			t.Errorf("expected the labels %s, but got %s", expected[i].labels, labels)
+		}
+		lines := make([]string, 0, len(s.entries))
+		for _, entry := range s.entries {
+			lines = append(lines, entry.GetLine())
+		}
+		if !reflect.DeepEqual(lines, expected[i].lines) {
+			t.Errorf("expected the lines %v of the stream %s, but got %v", expected[i].lines, expected[i].labels, lines)
+		}
+	}
+}
+
+func TestStructuredMetadata(t *testing.T) {
+	mapping := &labelMapping{serviceLabel: "service_name", structuredMetadata: true}
+	l := newLog("svc", 1000, "line", &common.KeyStringValuePair{Key: "http.status", Value: "200"})
+	l.Endpoint = "/users"
+	l.TraceContext = &logging.TraceContext{TraceId: "trace", TraceSegmentId: "segment", SpanId: 1}
+
+	labels, metadata := mapping.mapTags(l)
+	if !reflect.DeepEqual(labels, map[string]string{"service_name": "svc"}) {
+		t.Errorf("expected only the service label, but got %v", labels)
+	}
+	pairs := make(map[string]string, len(metadata))
+	for _, pair := range metadata {
+		pairs[pair.GetName()] = pair.GetValue()
+	}
+	expected := map[string]string{"trace_id": "trace", "segment_id": "segment", "span_id": "1", "endpoint": "/users", "http_status": "200"}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("expected the metadata %v, but got %v", expected, pairs)
+	}
+}
+
+func TestSanitizeLabelName(t *testing.T) {
+	tests := map[string]string{
+		"service_name": "service_name",
+		"http.method":  "http_method",
+		"1abc":         "_abc",
+		"a1-b":         "a1_b",
+	}
+	for name, expected := range tests {
+		if result := sanitizeLabelName(name); result != expected {
+			t.Errorf("expected the label name %s of %s, but got %s", expected, name, result)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package loki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/loki/logproto"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "loki-http-forwarder"
+	ShowName = "Loki HTTP Forwarder"
+)
+
+// The supported request encodings.
+const (
+	encodingProtobuf = "protobuf"
+	encodingJSON     = "json"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	URI                string            `mapstructure:"uri"`                 // The push URI of Loki.
+	Encoding           string            `mapstructure:"encoding"`            // The request encoding, supports "protobuf" and "json".
+	ServiceLabel       string            `mapstructure:"service_label"`       // The label name of the service.
+	InstanceLabel      string            `mapstructure:"instance_label"`      // The label name of the service instance, not a label when it is empty.
+	LevelTag           string            `mapstructure:"level_tag"`           // The tag key of the log level, which is mapped to the "level" label.
+	TagLabels          []string          `mapstructure:"tag_labels"`          // The tag keys mapped to the labels.
+	StaticLabels       map[string]string `mapstructure:"static_labels"`       // The static labels of every stream.
+	StructuredMetadata bool              `mapstructure:"structured_metadata"` // Whether to send the trace context and other tags as the metadata.
+
+	connection *http_client.Connection
+	mapping    *labelMapping
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization HTTP forwarder to push the SkyWalking native logs to the Grafana Loki. " +
+		"The service, instance, level and the selected tags are mapped to the stream labels. " +
+		"The request is failed when Loki responds a non-2xx status, so the batch could be retried by the fallbacker. " +
+		"The logs without the service are labeled by the \"unknown\" service, so every stream has at least one label."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The push URI of Loki.
+uri: "/loki/api/v1/push"
+# The request encoding, supports "protobuf"(compressed by snappy) and "json".
+encoding: "protobuf"
+# The label name of the service.
+service_label: "service_name"
+# The label name of the service instance, the instance is not a label when it is empty.
+instance_label: "service_instance"
+# The tag key of the log level, which is mapped to the "level" label.
+level_tag: "level"
+# The tag keys mapped to the labels, please only select the tags with low cardinality.
+tag_labels: []
+# The static labels of every stream, such as the cluster.
+static_labels: {}
+# Whether to send the trace context, endpoint and other tags as the structured metadata, which requires Loki 2.9 or newer.
+structured_metadata: false
+`
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	if f.Encoding != encodingProtobuf && f.Encoding != encodingJSON {
+		return fmt.Errorf("unsupported encoding: %s", f.Encoding)
+	}
+	if f.ServiceLabel == "" {
+		return fmt.Errorf("the service label is required")
+	}
+	f.connection = client
+	f.mapping = &labelMapping{
+		serviceLabel:       sanitizeLabelName(f.ServiceLabel),
+		instanceLabel:      sanitizeLabelName(f.InstanceLabel),
+		levelTag:           f.LevelTag,
+		tagLabels:          make(map[string]string, len(f.TagLabels)),
+		staticLabels:       make(map[string]string, len(f.StaticLabels)),
+		structuredMetadata: f.StructuredMetadata,
+	}
+	for _, tag := range f.TagLabels {
+		f.mapping.tagLabels[tag] = sanitizeLabelName(tag)
+	}
+	for k, v := range f.StaticLabels {
+		f.mapping.staticLabels[sanitizeLabelName(k)] = v
+	}
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	logs := make([]*logging.LogData, 0)
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_LogList)
+		if !ok {
+			continue
+		}
+		for _, l := range data.LogList.GetLogs() {
+			logData := &logging.LogData{}
+			if err := proto.Unmarshal(l, logData); err != nil {
+				log.Logger.Warnf("%s unmarshal the native log failure: %v", f.Name(), err)
+				continue
+			}
+			logs = append(logs, logData)
+		}
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+	streams := convertToStreams(logs, f.mapping)
+	var body []byte
+	var contentType string
+	var err error
+	if f.Encoding == encodingJSON {
+		body, err = encodeJSON(streams)
+		contentType = "application/json"
+	} else {
+		body, err = encodeProtobuf(streams)
+		contentType = "application/x-protobuf"
+	}
+	if err != nil {
+		return err
+	}
+	return f.push(body, contentType, len(logs))
+}
+
+func (f *Forwarder) push(body []byte, contentType string, count int) error {
+	req, err := f.connection.NewRequest(context.Background(), http.MethodPost, f.URI, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := f.connection.Do(req)
+	if err != nil {
+		log.Logger.Errorf("%s push logs error: %v", f.Name(), err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("%s push %d logs failure, status code: %d, response: %s", f.Name(), count, resp.StatusCode, msg)
+}
+
+func encodeProtobuf(streams []*stream) ([]byte, error) {
+	req := &logproto.PushRequest{Streams: make([]*logproto.StreamAdapter, 0, len(streams))}
+	for _, s := range streams {
+		req.Streams = append(req.Streams, &logproto.StreamAdapter{
+			Labels:  labelsString(s.labels),
+			Entries: s.entries,
+		})
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return snappy.Encode(nil, data), nil
+}
+
+type jsonPushRequest struct {
+	Streams []*jsonStream `json:"streams"`
+}
+
+type jsonStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][]interface{}   `json:"values"`
+}
+
+func encodeJSON(streams []*stream) ([]byte, error) {
+	req := &jsonPushRequest{Streams: make([]*jsonStream, 0, len(streams))}
+	for _, s := range streams {
+		js := &jsonStream{Stream: s.labels, Values: make([][]interface{}, 0, len(s.entries))}
+		for _, entry := range s.entries {
+			value := []interface{}{strconv.FormatInt(entry.GetTimestamp().AsTime().UnixNano(), 10), entry.GetLine()}
+			if len(entry.GetStructuredMetadata()) > 0 {
+				metadata := make(map[string]string, len(entry.GetStructuredMetadata()))
+				for _, pair := range entry.GetStructuredMetadata() {
+					metadata[pair.GetName()] = pair.GetValue()
+				}
+				value = append(value, metadata)
+			}
+			js.Values = append(js.Values, value)
+		}
+		req.Streams = append(req.Streams, js)
+	}
+	return json.Marshal(req)
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_Logging
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,124 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package loki
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/loki/logproto"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+// lokiStandIn is a local stand-in of the Loki push API, which responds the configured status.
+type lokiStandIn struct {
+	status      int
+	contentType string
+	body        []byte
+}
+
+func (s *lokiStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.contentType = r.Header.Get("Content-Type")
+	s.body, _ = io.ReadAll(r.Body)
+	w.WriteHeader(s.status)
+}
+
+func newForwarder(t *testing.T, encoding string, standIn http.Handler) *Forwarder {
+	log.Init(&log.LoggerConfig{})
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	client := &http_client.Client{ServerAddr: server.URL, Timeout: "5s", CheckPeriod: 5}
+	if err := client.Prepare(); err != nil {
+		t.Fatalf("cannot prepare the http client: %v", err)
+	}
+	f := &Forwarder{URI: "/loki/api/v1/push", Encoding: encoding, ServiceLabel: "service_name"}
+	if err := f.Prepare(client.GetConnectedClient()); err != nil {
+		t.Fatalf("cannot prepare the forwarder: %v", err)
+	}
+	return f
+}
+
+func newBatch(t *testing.T, service, line string) event.BatchEvents {
+	data, err := proto.Marshal(newLog(service, 1000, line))
+	if err != nil {
+		t.Fatalf("cannot marshal the log: %v", err)
+	}
+	return event.BatchEvents{{Data: &v1.SniffData_LogList{LogList: &v1.BatchLogList{Logs: [][]byte{data}}}}}
+}
+
+func TestPushProtobuf(t *testing.T) {
+	standIn := &lokiStandIn{status: http.StatusNoContent}
+	f := newForwarder(t, encodingProtobuf, standIn)
+	if err := f.Forward(newBatch(t, "svc", "line")); err != nil {
+		t.Fatalf("push error: %v", err)
+	}
+	if standIn.contentType != "application/x-protobuf" {
+		t.Errorf("unexpected content type: %s", standIn.contentType)
+	}
+	data, err := snappy.Decode(nil, standIn.body)
+	if err != nil {
+		t.Fatalf("cannot decode the snappy body: %v", err)
+	}
+	req := &logproto.PushRequest{}
+	if err := proto.Unmarshal(data, req); err != nil {
+		t.Fatalf("cannot unmarshal the push request: %v", err)
+	}
+	if len(req.Streams) != 1 || req.Streams[0].Labels != `{service_name="svc"}` || req.Streams[0].Entries[0].Line != "line" {
+		t.Errorf("unexpected push request: %v", req)
+	}
+}
+
+func TestPushJSON(t *testing.T) {
+	standIn := &lokiStandIn{status: http.StatusNoContent}
+	f := newForwarder(t, encodingJSON, standIn)
+	if err := f.Forward(newBatch(t, "", "line")); err != nil {
+		t.Fatalf("push error: %v", err)
+	}
+	if standIn.contentType != "application/json" {
+		t.Errorf("unexpected content type: %s", standIn.contentType)
+	}
+	req := &jsonPushRequest{}
+	if err := json.Unmarshal(standIn.body, req); err != nil {
+		t.Fatalf("cannot unmarshal the push request: %v", err)
+	}
+	if len(req.Streams) != 1 || req.Streams[0].Stream["service_name"] != unknownService {
+		t.Fatalf("expected the stream of the unknown service, but got %s", standIn.body)
+	}
+	if values := req.Streams[0].Values; len(values) != 1 || values[0][0] != "1000000000" || values[0][1] != "line" {
+		t.Errorf("unexpected stream values: %v", values)
+	}
+}
+
+func TestPushFailure(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusTooManyRequests, http.StatusInternalServerError} {
+		f := newForwarder(t, encodingProtobuf, &lokiStandIn{status: status})
+		if err := f.Forward(newBatch(t, "svc", "line")); err == nil {
+			t.Errorf("expected the error when Loki responds %d", status)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zipkinv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "zipkin-v2-http-forwarder"
+	ShowName = "Zipkin v2 HTTP Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	URI        string `mapstructure:"uri"` // The Zipkin spans report URI of the OAP Zipkin receiver.
+	connection *http_client.Connection
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization HTTP forwarder with the Zipkin v2 protocol, " +
+		"the spans are reported to the OAP Zipkin receiver in the protobuf encoding."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The Zipkin spans report URI of the OAP Zipkin receiver.
+uri: "/api/v2/spans"
+`
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.connection = client
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	// the concatenation of serialized ListOfSpans messages is a merged ListOfSpans message,
+	// so sending all spans in a single request without decoding them.
+	var body bytes.Buffer
+	for _, e := range batch {
+		if e.GetType() != event.ZipkinSpansType {
+			continue
+		}
+		body.Write(event.ExtensionData(e))
+	}
+	if body.Len() == 0 {
+		return nil
+	}
+	req, err := f.connection.NewRequest(context.Background(), http.MethodPost, f.URI, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := f.connection.Do(req)
+	if err != nil {
+		log.Logger.Errorf("%s send spans error: %v", f.Name(), err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s send spans failure, status code: %d, response: %s", f.Name(), resp.StatusCode, msg)
+	}
+	return nil
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.ZipkinSpansType
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativejvm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Shopify/sarama"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-jvm-kafka-forwarder"
+	ShowName = "Native JVM Kafka Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	Topic    string `mapstructure:"topic"` // The forwarder topic.
+	producer sarama.SyncProducer
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization Kafka forwarder with the SkyWalking native JVM metrics protocol, " +
+		"the message key is the service instance name, which is the same as the Kafka reporter of the SkyWalking agents."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The remote topic, which should be the same as the topicNameOfMetrics of the OAP kafka fetcher.
+topic: "skywalking-metrics"
+`
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(sarama.Client)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a kafka client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return err
+	}
+	f.producer = producer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	messages := make([]*sarama.ProducerMessage, 0, len(batch))
+	for _, e := range batch {
+		jvm := e.GetJvm()
+		if jvm == nil {
+			continue
+		}
+		data, err := proto.Marshal(jvm)
+		if err != nil {
+			log.Logger.Warnf("%s marshal the jvm metrics failure: %v", f.Name(), err)
+			continue
+		}
+		messages = append(messages, f.newMessage(jvm.GetServiceInstance(), data))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return f.producer.SendMessages(messages)
+}
+
+func (f *Forwarder) newMessage(key string, value []byte) *sarama.ProducerMessage {
+	return &sarama.ProducerMessage{
+		Topic: f.Topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(value),
+	}
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_JVMMetricType
+}
+
+func (f *Forwarder) SyncForward(_ *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -20,13 +20,17 @@ package nativelog
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/Shopify/sarama"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
 	"github.com/apache/skywalking-satellite/internal/satellite/event"
 
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
 	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
 )
 
@@ -35,10 +39,23 @@ const (
 	ShowName = "Native Log Kafka Forwarder"
 )
 
+// The header names of the satellite metadata.
+const (
+	headerPipe             = "satellite-pipe"
+	headerReceiveTimestamp = "satellite-receive-timestamp"
+	headerSourcePeer       = "satellite-source-peer"
+)
+
 type Forwarder struct {
 	config.CommonFields
-	Topic    string `mapstructure:"topic"` // The forwarder topic.
+	Topic         string   `mapstructure:"topic"`          // The forwarder topic, supports the {service}, {instance} and {layer} placeholders.
+	TopicServices []string `mapstructure:"topic_services"` // The services routed to the templated topic, all services are routed when it is empty.
+	FallbackTopic string   `mapstructure:"fallback_topic"` // The topic of the logs which could not be routed to the templated topic.
+	MessageKey    string   `mapstructure:"message_key"`    // The message key, supports "service", "instance" and "trace_id".
+	EnableHeaders bool     `mapstructure:"enable_headers"` // Whether to carry the pipe, receive timestamp and source peer in the message headers.
+
 	producer sarama.SyncProducer
+	router   *topicRouter
 }
 
 func (f *Forwarder) Name() string {
@@ -50,17 +67,38 @@ func (f *Forwarder) ShowName() string {
 }
 
 func (f *Forwarder) Description() string {
-	return "This is a synchronization Kafka forwarder with the SkyWalking native log protocol."
+	return "This is a synchronization Kafka forwarder with the SkyWalking native log protocol. " +
+		"The message key could be the service, instance or trace id to keep the ordering of them within the partitions, " +
+		"and the topic could be routed by the log fields, such as \"logs-{service}\"."
 }
 
 func (f *Forwarder) DefaultConfig() string {
 	return `
-# The remote topic. 
+# The remote topic, the {service}, {instance} and {layer} placeholders could be used to route the logs, such as "logs-{service}".
 topic: "log-topic"
+# The services routed to the templated topic, all services are routed when it is empty.
+topic_services: []
+# The topic of the logs which are not in the topic_services, miss the fields of the placeholders or could not build a valid topic,
+# required when the topic is templated.
+fallback_topic: ""
+# The message key, supports "service", "instance" and "trace_id". The message has no key when it is empty.
+message_key: ""
+# Whether to carry the satellite metadata in the message headers, which requires the Kafka version at least 0.11.0.
+enable_headers: false
 `
 }
 
 func (f *Forwarder) Prepare(connection interface{}) error {
+	switch f.MessageKey {
+	case "", messageKeyService, messageKeyInstance, messageKeyTraceID:
+	default:
+		return fmt.Errorf("unsupported message key: %s", f.MessageKey)
+	}
+	router, err := newTopicRouter(f.Topic, f.FallbackTopic, f.TopicServices)
+	if err != nil {
+		return err
+	}
+	f.router = router
 	client, ok := connection.(sarama.Client)
 	if !ok {
 		return fmt.Errorf("the %s is only accepet the kafka client, but receive a %s",
@@ -81,16 +119,48 @@ func (f *Forwarder) Forward(batch event.BatchEvents) error {
 		if !ok {
 			continue
 		}
+		headers := f.buildHeaders(e)
 		for _, l := range data.LogList.Logs {
-			message = append(message, &sarama.ProducerMessage{
-				Topic: f.Topic,
-				Value: sarama.ByteEncoder(l),
-			})
+			m := &sarama.ProducerMessage{
+				Topic:   f.router.defaultTopic,
+				Value:   sarama.ByteEncoder(l),
+				Headers: headers,
+			}
+			if f.MessageKey != "" || f.router.templated {
+				// the log could not be routed when it is not decoded, so it is sent to the default topic without the key.
+				logData := &logging.LogData{}
+				if err := proto.Unmarshal(l, logData); err != nil {
+					log.Logger.Warnf("%s unmarshal the native log failure, send it to the default topic: %v", f.Name(), err)
+				} else {
+					m.Topic = f.router.route(logData)
+					if key := messageKey(f.MessageKey, logData); key != "" {
+						m.Key = sarama.StringEncoder(key)
+					}
+				}
+			}
+			message = append(message, m)
 		}
 	}
+	if len(message) == 0 {
+		return nil
+	}
 	return f.producer.SendMessages(message)
 }
 
+func (f *Forwarder) buildHeaders(e *v1.SniffData) []sarama.RecordHeader {
+	if !f.EnableHeaders {
+		return nil
+	}
+	headers := []sarama.RecordHeader{
+		{Key: []byte(headerPipe), Value: []byte(f.PipeName)},
+		{Key: []byte(headerReceiveTimestamp), Value: []byte(strconv.FormatInt(e.GetTimestamp(), 10))},
+	}
+	if peer := e.GetMeta()[event.MetaSourcePeer]; peer != "" {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(headerSourcePeer), Value: []byte(peer)})
+	}
+	return headers
+}
+
 func (f *Forwarder) ForwardType() v1.SniffType {
 	return v1.SniffType_Logging
 }
@@ -0,0 +1,120 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+)
+
+// The supported message keys.
+const (
+	messageKeyService  = "service"
+	messageKeyInstance = "instance"
+	messageKeyTraceID  = "trace_id"
+)
+
+// The max length of the Kafka topic name.
+const maxTopicLength = 249
+
+var (
+	topicPlaceholders = regexp.MustCompile(`\{(service|instance|layer)\}`)
+	legalTopicName    = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+)
+
+// topicRouter decides the topic of each log by the topic template.
+type topicRouter struct {
+	template      string
+	templated     bool
+	defaultTopic  string
+	fallbackTopic string
+	services      map[string]bool
+}
+
+func newTopicRouter(template, fallbackTopic string, services []string) (*topicRouter, error) {
+	r := &topicRouter{
+		template:      template,
+		templated:     topicPlaceholders.MatchString(template),
+		defaultTopic:  template,
+		fallbackTopic: fallbackTopic,
+	}
+	if !r.templated {
+		if !isLegalTopic(template) {
+			return nil, fmt.Errorf("illegal topic: %s", template)
+		}
+		return r, nil
+	}
+	if !isLegalTopic(fallbackTopic) {
+		return nil, fmt.Errorf("the fallback topic is required to be a legal topic when the topic is templated, but receive: %s", fallbackTopic)
+	}
+	r.defaultTopic = fallbackTopic
+	if len(services) > 0 {
+		r.services = make(map[string]bool, len(services))
+		for _, s := range services {
+			r.services[s] = true
+		}
+	}
+	return r, nil
+}
+
+func (r *topicRouter) route(logData *logging.LogData) string {
+	if !r.templated {
+		return r.defaultTopic
+	}
+	if r.services != nil && !r.services[logData.GetService()] {
+		return r.fallbackTopic
+	}
+	missing := false
+	topic := topicPlaceholders.ReplaceAllStringFunc(r.template, func(placeholder string) string {
+		var value string
+		switch strings.Trim(placeholder, "{}") {
+		case "service":
+			value = logData.GetService()
+		case "instance":
+			value = logData.GetServiceInstance()
+		default:
+			value = logData.GetLayer()
+		}
+		missing = missing || value == ""
+		return value
+	})
+	if missing || !isLegalTopic(topic) {
+		return r.fallbackTopic
+	}
+	return topic
+}
+
+func messageKey(key string, logData *logging.LogData) string {
+	switch key {
+	case messageKeyService:
+		return logData.GetService()
+	case messageKeyInstance:
+		return logData.GetServiceInstance()
+	case messageKeyTraceID:
+		return logData.GetTraceContext().GetTraceId()
+	default:
+		return ""
+	}
+}
+
+func isLegalTopic(topic string) bool {
+	return topic != "" && len(topic) <= maxTopicLength && topic != "." && topic != ".." && legalTopicName.MatchString(topic)
+}
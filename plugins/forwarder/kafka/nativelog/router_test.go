@@ -0,0 +1,141 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelog
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+)
+
+func TestTopicRouter(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		services []string
+		log      *logging.LogData
+		topic    string
+	}{
+		{
+			name:     "static topic",
+			template: "log-topic",
+			log:      &logging.LogData{Service: "svc"},
+			topic:    "log-topic",
+		},
+		{
+			name:     "render service",
+			template: "logs-{service}",
+			log:      &logging.LogData{Service: "svc"},
+			topic:    "logs-svc",
+		},
+		{
+			name:     "render all placeholders",
+			template: "logs-{layer}-{service}-{instance}",
+			log:      &logging.LogData{Service: "svc", ServiceInstance: "ins", Layer: "GENERAL"},
+			topic:    "logs-GENERAL-svc-ins",
+		},
+		{
+			name:     "missing field",
+			template: "logs-{service}-{layer}",
+			log:      &logging.LogData{Service: "svc"},
+			topic:    "fallback",
+		},
+		{
+			name:     "missing all fields",
+			template: "{service}",
+			log:      &logging.LogData{},
+			topic:    "fallback",
+		},
+		{
+			name:     "illegal topic",
+			template: "logs-{service}",
+			log:      &logging.LogData{Service: "svc a"},
+			topic:    "fallback",
+		},
+		{
+			name:     "routed service",
+			template: "logs-{service}",
+			services: []string{"svc"},
+			log:      &logging.LogData{Service: "svc"},
+			topic:    "logs-svc",
+		},
+		{
+			name:     "not routed service",
+			template: "logs-{service}",
+			services: []string{"svc"},
+			log:      &logging.LogData{Service: "other"},
+			topic:    "fallback",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, err := newTopicRouter(tt.template, "fallback", tt.services)
+			if err != nil {
+				t.Fatalf("cannot create the router: %v", err)
+			}
+			if topic := router.route(tt.log); topic != tt.topic {
+				t.Errorf("expected topic %s, but got %s", tt.topic, topic)
+			}
+		})
+	}
+}
+
+func TestIllegalTopicRouter(t *testing.T) {
+	if _, err := newTopicRouter("log topic", "", nil); err == nil {
+		t.Errorf("expected the error of the illegal topic")
+	}
+	if _, err := newTopicRouter("logs-{service}", "", nil); err == nil {
+		t.Errorf("expected the error of the missing fallback topic")
+	}
+}
+
+type recordProducer struct {
+	sarama.SyncProducer
+	messages []*sarama.ProducerMessage
+}
+
+func (p *recordProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	p.messages = append(p.messages, msgs...)
+	return nil
+}
+
+func TestForwardUndecodedLog(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	router, err := newTopicRouter("logs-{service}", "fallback", nil)
+	if err != nil {
+		t.Fatalf("cannot create the router: %v", err)
+	}
+	producer := &recordProducer{}
+	f := &Forwarder{MessageKey: messageKeyService, producer: producer, router: router}
+	batch := event.BatchEvents{{Data: &v1.SniffData_LogList{LogList: &v1.BatchLogList{Logs: [][]byte{[]byte("not a log")}}}}}
+	if err := f.Forward(batch); err != nil {
+		t.Fatalf("forward error: %v", err)
+	}
+	if len(producer.messages) != 1 {
+		t.Fatalf("expected the undecoded log is sent, but got %d messages", len(producer.messages))
+	}
+	if m := producer.messages[0]; m.Topic != "fallback" || m.Key != nil {
+		t.Errorf("expected the undecoded log is sent to the default topic without the key, but got topic %s, key %v", m.Topic, m.Key)
+	}
+}
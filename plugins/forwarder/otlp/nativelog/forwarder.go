@@ -0,0 +1,198 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelog
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/otlp"
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+	logsv1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/logs/v1"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-log-otlp-grpc-forwarder"
+	ShowName = "Native Log OTLP GRPC Forwarder"
+)
+
+// The attributes of the SkyWalking log fields which have no OpenTelemetry counterparts.
+const (
+	endpointAttribute = "sw.endpoint"
+	layerAttribute    = "sw.layer"
+	bodyTypeAttribute = "sw.body_type"
+)
+
+// severityNumbers maps the levels to the severity numbers, the other levels are kept as the severity text only.
+var severityNumbers = map[string]logsv1.SeverityNumber{
+	"TRACE":   logsv1.SeverityNumber_SEVERITY_NUMBER_TRACE,
+	"DEBUG":   logsv1.SeverityNumber_SEVERITY_NUMBER_DEBUG,
+	"INFO":    logsv1.SeverityNumber_SEVERITY_NUMBER_INFO,
+	"WARN":    logsv1.SeverityNumber_SEVERITY_NUMBER_WARN,
+	"WARNING": logsv1.SeverityNumber_SEVERITY_NUMBER_WARN,
+	"ERROR":   logsv1.SeverityNumber_SEVERITY_NUMBER_ERROR,
+	"FATAL":   logsv1.SeverityNumber_SEVERITY_NUMBER_FATAL,
+}
+
+type Forwarder struct {
+	config.CommonFields
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"` // The static attributes attached to every resource.
+	LevelTag           string            `mapstructure:"level_tag"`           // The tag key of the log level, which is mapped to the severity.
+
+	logsClient logs.LogsServiceClient
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization grpc forwarder to export the SkyWalking native logs as the OpenTelemetry log records by the OTLP. " +
+		"The level tag is mapped to the severity, the other tags are the attributes, and the trace context is converted " +
+		"to the same trace ID and span ID as the native-tracing-otlp-grpc-forwarder."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return otlp.DefaultResourceConfig + `# The tag key of the log level, which is mapped to the severity.
+level_tag: "level"
+`
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*grpc.ClientConn)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a grpc client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.logsClient = logs.NewLogsServiceClient(client)
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	resources := make(map[otlp.ResourceKey]*logsv1.ScopeLogs)
+	req := &logs.ExportLogsServiceRequest{}
+	count := 0
+	observed := uint64(time.Now().UnixNano())
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_LogList)
+		if !ok {
+			continue
+		}
+		for _, l := range data.LogList.GetLogs() {
+			logData := &logging.LogData{}
+			if err := proto.Unmarshal(l, logData); err != nil {
+				log.Logger.Warnf("%s unmarshal the native log failure: %v", f.Name(), err)
+				continue
+			}
+			key := otlp.ResourceKey{Service: logData.GetService(), Instance: logData.GetServiceInstance()}
+			scopeLogs, exist := resources[key]
+			if !exist {
+				scopeLogs = &logsv1.ScopeLogs{Scope: &common.InstrumentationScope{Name: otlp.ScopeName}}
+				resources[key] = scopeLogs
+				req.ResourceLogs = append(req.ResourceLogs, &logsv1.ResourceLogs{
+					Resource:  otlp.NewResource(key, f.ResourceAttributes),
+					ScopeLogs: []*logsv1.ScopeLogs{scopeLogs},
+				})
+			}
+			scopeLogs.LogRecords = append(scopeLogs.LogRecords, f.convertLog(logData, observed))
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	resp, err := f.logsClient.Export(context.Background(), req)
+	if err != nil {
+		log.Logger.Errorf("%s export logs error: %v", f.Name(), err)
+		return err
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedLogRecords(); rejected > 0 {
+		log.Logger.Warnf("%s %d of %d logs are rejected: %s", f.Name(), rejected, count, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func (f *Forwarder) convertLog(l *logging.LogData, observed uint64) *logsv1.LogRecord {
+	record := &logsv1.LogRecord{
+		TimeUnixNano:         otlp.UnixNano(l.GetTimestamp()),
+		ObservedTimeUnixNano: observed,
+	}
+	if l.GetEndpoint() != "" {
+		record.Attributes = append(record.Attributes, otlp.StringAttribute(endpointAttribute, l.GetEndpoint()))
+	}
+	if l.GetLayer() != "" {
+		record.Attributes = append(record.Attributes, otlp.StringAttribute(layerAttribute, l.GetLayer()))
+	}
+	for _, tag := range l.GetTags().GetData() {
+		if tag.GetKey() == f.LevelTag && f.LevelTag != "" {
+			record.SeverityText = tag.GetValue()
+			record.SeverityNumber = severityNumbers[strings.ToUpper(tag.GetValue())]
+			continue
+		}
+		record.Attributes = append(record.Attributes, otlp.StringAttribute(tag.GetKey(), tag.GetValue()))
+	}
+	var body, bodyType string
+	switch b := l.GetBody(); {
+	case b.GetText() != nil:
+		body, bodyType = b.GetText().GetText(), "text"
+	case b.GetJson() != nil:
+		body, bodyType = b.GetJson().GetJson(), "json"
+	case b.GetYaml() != nil:
+		body, bodyType = b.GetYaml().GetYaml(), "yaml"
+	}
+	record.Body = &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: body}}
+	if bodyType != "" {
+		record.Attributes = append(record.Attributes, otlp.StringAttribute(bodyTypeAttribute, bodyType))
+	}
+	if tc := l.GetTraceContext(); tc.GetTraceId() != "" {
+		record.TraceId = otlp.TraceID(tc.GetTraceId())
+		if tc.GetTraceSegmentId() != "" {
+			record.SpanId = otlp.SpanID(tc.GetTraceSegmentId(), tc.GetSpanId())
+		}
+	}
+	return record
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_Logging
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
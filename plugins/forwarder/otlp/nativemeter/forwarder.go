@@ -0,0 +1,222 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativemeter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/otlp"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	metrics "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/collector/metrics/v1"
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	metricsv1 "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/metrics/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-meter-otlp-grpc-forwarder"
+	ShowName = "Native Meter OTLP GRPC Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"` // The static attributes attached to every resource.
+
+	metricsClient metrics.MetricsServiceClient
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization grpc forwarder to export the SkyWalking native meters as the OpenTelemetry metrics by the OTLP. " +
+		"The single values are converted to the gauges, and the histograms are converted to the cumulative histograms without the sum, " +
+		"the lower bounds of the SkyWalking buckets are the explicit bounds of the previous buckets."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return otlp.DefaultResourceConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*grpc.ClientConn)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a grpc client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.metricsClient = metrics.NewMetricsServiceClient(client)
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	c := &converter{
+		resourceAttributes: f.ResourceAttributes,
+		resources:          make(map[otlp.ResourceKey]*metricsv1.ScopeMetrics),
+		req:                &metrics.ExportMetricsServiceRequest{},
+	}
+	for _, e := range batch {
+		switch data := e.GetData().(type) {
+		case *v1.SniffData_MeterCollection:
+			c.addMeters(data.MeterCollection.GetMeterData(), e.GetTimestamp())
+		case *v1.SniffData_Meter:
+			c.addMeters([]*meter.MeterData{data.Meter}, e.GetTimestamp())
+		}
+	}
+	if c.count == 0 {
+		return nil
+	}
+	resp, err := f.metricsClient.Export(context.Background(), c.req)
+	if err != nil {
+		log.Logger.Errorf("%s export metrics error: %v", f.Name(), err)
+		return err
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedDataPoints(); rejected > 0 {
+		log.Logger.Warnf("%s %d of %d data points are rejected: %s", f.Name(), rejected, c.count, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+type converter struct {
+	resourceAttributes map[string]string
+	resources          map[otlp.ResourceKey]*metricsv1.ScopeMetrics
+	req                *metrics.ExportMetricsServiceRequest
+	count              int
+}
+
+// addMeters adds the meters to the request, the service, instance and timestamp are only reported by the first meter
+// of a collection, so they are inherited from the previous meter when absent.
+func (c *converter) addMeters(meters []*meter.MeterData, defaultTimestamp int64) {
+	var key otlp.ResourceKey
+	timestamp := defaultTimestamp
+	for _, m := range meters {
+		if m.GetService() != "" {
+			key = otlp.ResourceKey{Service: m.GetService(), Instance: m.GetServiceInstance()}
+		}
+		if m.GetTimestamp() > 0 {
+			timestamp = m.GetTimestamp()
+		}
+		if key.Service == "" {
+			continue
+		}
+		var metric *metricsv1.Metric
+		if single := m.GetSingleValue(); single != nil {
+			metric = convertSingleValue(single, timestamp)
+		} else if histogram := m.GetHistogram(); histogram != nil {
+			metric = convertHistogram(histogram, timestamp)
+		} else {
+			continue
+		}
+		scopeMetrics := c.scopeMetrics(key)
+		scopeMetrics.Metrics = append(scopeMetrics.Metrics, metric)
+		c.count++
+	}
+}
+
+func (c *converter) scopeMetrics(key otlp.ResourceKey) *metricsv1.ScopeMetrics {
+	scopeMetrics, exist := c.resources[key]
+	if !exist {
+		scopeMetrics = &metricsv1.ScopeMetrics{Scope: &common.InstrumentationScope{Name: otlp.ScopeName}}
+		c.resources[key] = scopeMetrics
+		c.req.ResourceMetrics = append(c.req.ResourceMetrics, &metricsv1.ResourceMetrics{
+			Resource:     otlp.NewResource(key, c.resourceAttributes),
+			ScopeMetrics: []*metricsv1.ScopeMetrics{scopeMetrics},
+		})
+	}
+	return scopeMetrics
+}
+
+func convertSingleValue(single *meter.MeterSingleValue, timestamp int64) *metricsv1.Metric {
+	return &metricsv1.Metric{
+		Name: single.GetName(),
+		Data: &metricsv1.Metric_Gauge{Gauge: &metricsv1.Gauge{DataPoints: []*metricsv1.NumberDataPoint{{
+			Attributes:   convertLabels(single.GetLabels()),
+			TimeUnixNano: otlp.UnixNano(timestamp),
+			Value:        &metricsv1.NumberDataPoint_AsDouble{AsDouble: single.GetValue()},
+		}}}},
+	}
+}
+
+// convertHistogram converts the SkyWalking buckets keyed by the lower bound to the buckets split by the explicit bounds.
+func convertHistogram(histogram *meter.MeterHistogram, timestamp int64) *metricsv1.Metric {
+	values := make([]*meter.MeterBucketValue, len(histogram.GetValues()))
+	copy(values, histogram.GetValues())
+	sort.Slice(values, func(i, j int) bool {
+		return lowerBound(values[i]) < lowerBound(values[j])
+	})
+	point := &metricsv1.HistogramDataPoint{
+		Attributes:   convertLabels(histogram.GetLabels()),
+		TimeUnixNano: otlp.UnixNano(timestamp),
+		BucketCounts: make([]uint64, 0, len(values)),
+	}
+	for i, v := range values {
+		if i > 0 {
+			point.ExplicitBounds = append(point.ExplicitBounds, lowerBound(v))
+		}
+		point.BucketCounts = append(point.BucketCounts, uint64(v.GetCount()))
+		point.Count += uint64(v.GetCount())
+	}
+	return &metricsv1.Metric{
+		Name: histogram.GetName(),
+		Data: &metricsv1.Metric_Histogram{Histogram: &metricsv1.Histogram{
+			DataPoints:             []*metricsv1.HistogramDataPoint{point},
+			AggregationTemporality: metricsv1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}},
+	}
+}
+
+func lowerBound(v *meter.MeterBucketValue) float64 {
+	if v.GetIsNegativeInfinity() {
+		return math.Inf(-1)
+	}
+	return v.GetBucket()
+}
+
+func convertLabels(labels []*meter.Label) []*common.KeyValue {
+	attributes := make([]*common.KeyValue, 0, len(labels))
+	for _, l := range labels {
+		attributes = append(attributes, otlp.StringAttribute(l.GetName(), l.GetValue()))
+	}
+	return attributes
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_MeterType
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,230 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativetracing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/otlp"
+	trace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	tracev1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/trace/v1"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-tracing-otlp-grpc-forwarder"
+	ShowName = "Native Tracing OTLP GRPC Forwarder"
+)
+
+// The attributes of the SkyWalking span fields which have no OpenTelemetry counterparts.
+const (
+	segmentIDAttribute   = "sw.segment_id"
+	spanLayerAttribute   = "sw.span_layer"
+	componentIDAttribute = "sw.component_id"
+	peerAttribute        = "net.peer.name"
+	logEventName         = "log"
+	logEventKey          = "event"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"` // The static attributes attached to every resource.
+
+	traceClient trace.TraceServiceClient
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization grpc forwarder to export the SkyWalking native segments as the OpenTelemetry spans by the OTLP. " +
+		"The trace ID in 32 hex characters is kept, the other trace IDs and the span IDs are hashed, so they are the same " +
+		"in the spans and the logs exported by the native-log-otlp-grpc-forwarder. The first reference of the entry span is the parent span, " +
+		"and the other references are the links."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return otlp.DefaultResourceConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*grpc.ClientConn)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a grpc client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	f.traceClient = trace.NewTraceServiceClient(client)
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	resources := make(map[otlp.ResourceKey]*tracev1.ScopeSpans)
+	req := &trace.ExportTraceServiceRequest{}
+	count := 0
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_Segment)
+		if !ok {
+			continue
+		}
+		segment := &agent.SegmentObject{}
+		if err := proto.Unmarshal(data.Segment, segment); err != nil {
+			log.Logger.Warnf("%s unmarshal the segment failure: %v", f.Name(), err)
+			continue
+		}
+		key := otlp.ResourceKey{Service: segment.GetService(), Instance: segment.GetServiceInstance()}
+		scopeSpans, exist := resources[key]
+		if !exist {
+			scopeSpans = &tracev1.ScopeSpans{Scope: &common.InstrumentationScope{Name: otlp.ScopeName}}
+			resources[key] = scopeSpans
+			req.ResourceSpans = append(req.ResourceSpans, &tracev1.ResourceSpans{
+				Resource:   otlp.NewResource(key, f.ResourceAttributes),
+				ScopeSpans: []*tracev1.ScopeSpans{scopeSpans},
+			})
+		}
+		spans := convertSegment(segment)
+		scopeSpans.Spans = append(scopeSpans.Spans, spans...)
+		count += len(spans)
+	}
+	if count == 0 {
+		return nil
+	}
+	resp, err := f.traceClient.Export(context.Background(), req)
+	if err != nil {
+		log.Logger.Errorf("%s export spans error: %v", f.Name(), err)
+		return err
+	}
+	if rejected := resp.GetPartialSuccess().GetRejectedSpans(); rejected > 0 {
+		log.Logger.Warnf("%s %d of %d spans are rejected: %s", f.Name(), rejected, count, resp.GetPartialSuccess().GetErrorMessage())
+	}
+	return nil
+}
+
+func convertSegment(segment *agent.SegmentObject) []*tracev1.Span {
+	traceID := otlp.TraceID(segment.GetTraceId())
+	spans := make([]*tracev1.Span, 0, len(segment.GetSpans()))
+	for _, s := range segment.GetSpans() {
+		span := &tracev1.Span{
+			TraceId:           traceID,
+			SpanId:            otlp.SpanID(segment.GetTraceSegmentId(), s.GetSpanId()),
+			Name:              s.GetOperationName(),
+			Kind:              spanKind(s),
+			StartTimeUnixNano: otlp.UnixNano(s.GetStartTime()),
+			EndTimeUnixNano:   otlp.UnixNano(s.GetEndTime()),
+			Attributes:        spanAttributes(segment, s),
+			Events:            spanEvents(s.GetLogs()),
+			Status:            &tracev1.Status{},
+		}
+		if s.GetIsError() {
+			span.Status.Code = tracev1.Status_STATUS_CODE_ERROR
+		}
+		if s.GetParentSpanId() >= 0 {
+			span.ParentSpanId = otlp.SpanID(segment.GetTraceSegmentId(), s.GetParentSpanId())
+		}
+		for i, ref := range s.GetRefs() {
+			parentSpanID := otlp.SpanID(ref.GetParentTraceSegmentId(), ref.GetParentSpanId())
+			if i == 0 && len(span.ParentSpanId) == 0 {
+				span.ParentSpanId = parentSpanID
+				continue
+			}
+			span.Links = append(span.Links, &tracev1.Span_Link{TraceId: otlp.TraceID(ref.GetTraceId()), SpanId: parentSpanID})
+		}
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func spanKind(s *agent.SpanObject) tracev1.Span_SpanKind {
+	switch s.GetSpanType() {
+	case agent.SpanType_Entry:
+		if s.GetSpanLayer() == agent.SpanLayer_MQ {
+			return tracev1.Span_SPAN_KIND_CONSUMER
+		}
+		return tracev1.Span_SPAN_KIND_SERVER
+	case agent.SpanType_Exit:
+		if s.GetSpanLayer() == agent.SpanLayer_MQ {
+			return tracev1.Span_SPAN_KIND_PRODUCER
+		}
+		return tracev1.Span_SPAN_KIND_CLIENT
+	default:
+		return tracev1.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func spanAttributes(segment *agent.SegmentObject, s *agent.SpanObject) []*common.KeyValue {
+	attributes := make([]*common.KeyValue, 0, len(s.GetTags())+4)
+	attributes = append(attributes, otlp.StringAttribute(segmentIDAttribute, segment.GetTraceSegmentId()))
+	if s.GetSpanLayer() != agent.SpanLayer_Unknown {
+		attributes = append(attributes, otlp.StringAttribute(spanLayerAttribute, strings.ToLower(s.GetSpanLayer().String())))
+	}
+	if s.GetComponentId() > 0 {
+		attributes = append(attributes, otlp.IntAttribute(componentIDAttribute, int64(s.GetComponentId())))
+	}
+	if s.GetPeer() != "" {
+		attributes = append(attributes, otlp.StringAttribute(peerAttribute, s.GetPeer()))
+	}
+	for _, tag := range s.GetTags() {
+		attributes = append(attributes, otlp.StringAttribute(tag.GetKey(), tag.GetValue()))
+	}
+	return attributes
+}
+
+// spanEvents converts the logs of the span to the events, the "event" field is the event name when it exists.
+func spanEvents(logs []*agent.Log) []*tracev1.Span_Event {
+	events := make([]*tracev1.Span_Event, 0, len(logs))
+	for _, l := range logs {
+		e := &tracev1.Span_Event{TimeUnixNano: otlp.UnixNano(l.GetTime()), Name: logEventName}
+		for _, kv := range l.GetData() {
+			if kv.GetKey() == logEventKey {
+				e.Name = kv.GetValue()
+				continue
+			}
+			e.Attributes = append(e.Attributes, otlp.StringAttribute(kv.GetKey(), kv.GetValue()))
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_TracingType
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
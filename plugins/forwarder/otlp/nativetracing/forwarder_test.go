@@ -0,0 +1,84 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativetracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/apache/skywalking-satellite/plugins/forwarder/otlp"
+	tracev1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/trace/v1"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+func TestConvertSegment(t *testing.T) {
+	segment := &agent.SegmentObject{
+		TraceId:        "4bf92f3577b34da6a3ce929d0e0e4736",
+		TraceSegmentId: "segment-b",
+		Spans: []*agent.SpanObject{
+			{
+				SpanId: 0, ParentSpanId: -1, StartTime: 1000, EndTime: 1200, OperationName: "/orders",
+				SpanType: agent.SpanType_Entry, SpanLayer: agent.SpanLayer_Http,
+				Refs: []*agent.SegmentReference{
+					{TraceId: "4bf92f3577b34da6a3ce929d0e0e4736", ParentTraceSegmentId: "segment-a", ParentSpanId: 1},
+					{TraceId: "other-trace", ParentTraceSegmentId: "segment-c", ParentSpanId: 2},
+				},
+			},
+			{
+				SpanId: 1, ParentSpanId: 0, StartTime: 1050, EndTime: 1100, OperationName: "kafka/orders/Producer",
+				SpanType: agent.SpanType_Exit, SpanLayer: agent.SpanLayer_MQ, Peer: "kafka:9092", IsError: true,
+				Logs: []*agent.Log{{Time: 1080, Data: []*common.KeyStringValuePair{
+					{Key: "event", Value: "error"},
+					{Key: "message", Value: "timeout"},
+				}}},
+			},
+		},
+	}
+	spans := convertSegment(segment)
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, but got %d", len(spans))
+	}
+	entry, exit := spans[0], spans[1]
+	if hex.EncodeToString(entry.GetTraceId()) != segment.GetTraceId() {
+		t.Errorf("expected the hex trace ID is kept, but got %x", entry.GetTraceId())
+	}
+	if !bytes.Equal(entry.GetParentSpanId(), otlp.SpanID("segment-a", 1)) {
+		t.Errorf("expected the first reference is the parent span")
+	}
+	if len(entry.GetLinks()) != 1 || !bytes.Equal(entry.GetLinks()[0].GetTraceId(), otlp.TraceID("other-trace")) {
+		t.Errorf("expected the other references are the links, but got %v", entry.GetLinks())
+	}
+	if entry.GetKind() != tracev1.Span_SPAN_KIND_SERVER || exit.GetKind() != tracev1.Span_SPAN_KIND_PRODUCER {
+		t.Errorf("unexpected span kinds: %v, %v", entry.GetKind(), exit.GetKind())
+	}
+	if !bytes.Equal(exit.GetParentSpanId(), entry.GetSpanId()) || !bytes.Equal(exit.GetSpanId(), otlp.SpanID("segment-b", 1)) {
+		t.Errorf("expected the exit span is the child of the entry span")
+	}
+	if exit.GetStatus().GetCode() != tracev1.Status_STATUS_CODE_ERROR || exit.GetStartTimeUnixNano() != 1050*1e6 {
+		t.Errorf("unexpected exit span: %v", exit)
+	}
+	if len(exit.GetEvents()) != 1 || exit.GetEvents()[0].GetName() != "error" || len(exit.GetEvents()[0].GetAttributes()) != 1 {
+		t.Errorf("expected the log is converted to the event, but got %v", exit.GetEvents())
+	}
+	if len(otlp.TraceID("not-hex")) != 16 {
+		t.Errorf("expected the hashed trace ID has 16 bytes")
+	}
+}
@@ -0,0 +1,112 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+)
+
+// The resource attributes of the service and the service instance.
+const (
+	ServiceNameAttribute       = "service.name"
+	ServiceInstanceIDAttribute = "service.instance.id"
+)
+
+// ScopeName is the instrumentation scope name of the converted data.
+const ScopeName = "skywalking-satellite"
+
+// DefaultResourceConfig is the default config of the resource attributes field shared by the OTLP forwarders.
+const DefaultResourceConfig = `
+# The static attributes attached to every resource, such as "deployment.environment".
+resource_attributes: {}
+`
+
+// ResourceKey is the service and the service instance of the resource.
+type ResourceKey struct {
+	Service  string
+	Instance string
+}
+
+// NewResource builds the resource of the service instance with the static attributes,
+// the static attributes don't override the service and the service instance.
+func NewResource(key ResourceKey, static map[string]string) *resource.Resource {
+	attributes := make([]*common.KeyValue, 0, len(static)+2)
+	attributes = append(attributes, StringAttribute(ServiceNameAttribute, key.Service))
+	if key.Instance != "" {
+		attributes = append(attributes, StringAttribute(ServiceInstanceIDAttribute, key.Instance))
+	}
+	keys := make([]string, 0, len(static))
+	for k := range static {
+		if k != ServiceNameAttribute && k != ServiceInstanceIDAttribute {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attributes = append(attributes, StringAttribute(k, static[k]))
+	}
+	return &resource.Resource{Attributes: attributes}
+}
+
+// StringAttribute builds the attribute with the string value.
+func StringAttribute(key, value string) *common.KeyValue {
+	return &common.KeyValue{Key: key, Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: value}}}
+}
+
+// IntAttribute builds the attribute with the int value.
+func IntAttribute(key string, value int64) *common.KeyValue {
+	return &common.KeyValue{Key: key, Value: &common.AnyValue{Value: &common.AnyValue_IntValue{IntValue: value}}}
+}
+
+// TraceID converts the SkyWalking trace ID to the 16 bytes trace ID. The ID in 32 hex characters is decoded directly,
+// so the trace propagated from OpenTelemetry keeps the same ID, and the others are hashed.
+func TraceID(id string) []byte {
+	if len(id) == 32 {
+		if decoded, err := hex.DecodeString(id); err == nil {
+			return decoded
+		}
+	}
+	hash := fnv.New128a()
+	_, _ = hash.Write([]byte(id))
+	return hash.Sum(nil)
+}
+
+// SpanID converts the span of the segment to the 8 bytes span ID, so the span ID is the same in the converted
+// spans, the parent references of the spans and the logs.
+func SpanID(segmentID string, spanID int32) []byte {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(segmentID))
+	_, _ = hash.Write([]byte{'-'})
+	_, _ = hash.Write([]byte(strconv.FormatInt(int64(spanID), 10)))
+	return binary.BigEndian.AppendUint64(nil, hash.Sum64())
+}
+
+// UnixNano converts the timestamp in milliseconds to nanoseconds.
+func UnixNano(millis int64) uint64 {
+	if millis <= 0 {
+		return 0
+	}
+	return uint64(millis) * 1e6
+}
@@ -0,0 +1,159 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package envoymetricsv3
+
+import (
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite"
+
+	envoy "skywalking.apache.org/repo/goapi/proto/envoy/service/metrics/v3"
+	prometheus "skywalking.apache.org/repo/goapi/proto/io/prometheus/client"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "envoy-metrics-v3-prometheus-remote-write-forwarder"
+	ShowName = "Envoy Metrics v3 Prometheus Remote Write Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	prometheusremotewrite.WriteConfig `mapstructure:",squash"`
+
+	writer *prometheusremotewrite.Writer
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization forwarder to send the Envoy metrics v3 to the Prometheus remote write endpoint. " +
+		"The cluster of the Envoy node is the service, and the ID of the Envoy node is the service instance. " +
+		"The request is failed when the endpoint responds 429 or 5xx, so the batch could be retried by the fallbacker."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return prometheusremotewrite.DefaultWriteConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	writer, err := prometheusremotewrite.NewWriter(f.Name(), &f.WriteConfig, client)
+	if err != nil {
+		return err
+	}
+	f.writer = writer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	set := f.writer.NewSeriesSet()
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_EnvoyMetricsV3List)
+		if !ok {
+			continue
+		}
+		addMessages(set, data.EnvoyMetricsV3List.GetMessages(), e.GetTimestamp())
+	}
+	if set.Len() == 0 {
+		return nil
+	}
+	return f.writer.Write(set)
+}
+
+// addMessages adds the metrics of the messages to the set, the identifier is only carried by the first message.
+func addMessages(set *prometheusremotewrite.SeriesSet, messages []*envoy.StreamMetricsMessage, defaultTimestamp int64) {
+	var service, instance string
+	for _, message := range messages {
+		if node := message.GetIdentifier().GetNode(); node != nil {
+			service, instance = node.GetCluster(), node.GetId()
+		}
+		if service == "" {
+			continue
+		}
+		for _, family := range message.GetEnvoyMetrics() {
+			for _, m := range family.GetMetric() {
+				timestamp := defaultTimestamp
+				if m.GetTimestampMs() > 0 {
+					timestamp = m.GetTimestampMs()
+				}
+				addMetric(set, service, instance, family.GetName(), family.GetType(), m, timestamp)
+			}
+		}
+	}
+}
+
+func addMetric(set *prometheusremotewrite.SeriesSet, service, instance, name string, metricType prometheus.MetricType,
+	m *prometheus.Metric, timestamp int64) {
+	labels := make(map[string]string, len(m.GetLabel()))
+	for _, l := range m.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	switch metricType {
+	case prometheus.MetricType_COUNTER:
+		set.Add(service, instance, name, labels, m.GetCounter().GetValue(), timestamp)
+	case prometheus.MetricType_GAUGE:
+		set.Add(service, instance, name, labels, m.GetGauge().GetValue(), timestamp)
+	case prometheus.MetricType_UNTYPED:
+		set.Add(service, instance, name, labels, m.GetUntyped().GetValue(), timestamp)
+	case prometheus.MetricType_SUMMARY:
+		summary := m.GetSummary()
+		for _, q := range summary.GetQuantile() {
+			quantile := prometheusremotewrite.WithLabel(labels, "quantile", prometheusremotewrite.FormatFloat(q.GetQuantile()))
+			set.Add(service, instance, name, quantile, q.GetValue(), timestamp)
+		}
+		set.Add(service, instance, name+"_sum", labels, summary.GetSampleSum(), timestamp)
+		set.Add(service, instance, name+"_count", labels, float64(summary.GetSampleCount()), timestamp)
+	case prometheus.MetricType_HISTOGRAM:
+		histogram := m.GetHistogram()
+		buckets := make([]prometheusremotewrite.Bucket, 0, len(histogram.GetBucket()))
+		for _, b := range histogram.GetBucket() {
+			buckets = append(buckets, prometheusremotewrite.Bucket{UpperBound: b.GetUpperBound(), Count: float64(b.GetCumulativeCount())})
+		}
+		set.AddHistogram(service, instance, name, labels, buckets, float64(histogram.GetSampleCount()), timestamp)
+		set.Add(service, instance, name+"_sum", labels, histogram.GetSampleSum(), timestamp)
+	}
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_EnvoyMetricsV3Type
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
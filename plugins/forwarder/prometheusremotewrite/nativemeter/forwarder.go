@@ -0,0 +1,164 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativemeter
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "native-meter-prometheus-remote-write-forwarder"
+	ShowName = "Native Meter Prometheus Remote Write Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	prometheusremotewrite.WriteConfig `mapstructure:",squash"`
+
+	writer *prometheusremotewrite.Writer
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization forwarder to send the SkyWalking native meters to the Prometheus remote write endpoint, " +
+		"such as Prometheus, Mimir or Thanos. The single values are converted to the gauges, and the histograms are converted to " +
+		"the cumulative \"_bucket\" and \"_count\" series, the lower bounds of the SkyWalking buckets are the \"le\" of the previous buckets. " +
+		"The request is failed when the endpoint responds 429 or 5xx, so the batch could be retried by the fallbacker."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return prometheusremotewrite.DefaultWriteConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	writer, err := prometheusremotewrite.NewWriter(f.Name(), &f.WriteConfig, client)
+	if err != nil {
+		return err
+	}
+	f.writer = writer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	set := f.writer.NewSeriesSet()
+	for _, e := range batch {
+		switch data := e.GetData().(type) {
+		case *v1.SniffData_MeterCollection:
+			addMeters(set, data.MeterCollection.GetMeterData(), e.GetTimestamp())
+		case *v1.SniffData_Meter:
+			addMeters(set, []*meter.MeterData{data.Meter}, e.GetTimestamp())
+		}
+	}
+	if set.Len() == 0 {
+		return nil
+	}
+	return f.writer.Write(set)
+}
+
+// addMeters adds the meters to the set, the service, instance and timestamp are only reported by the first meter
+// of a collection, so they are inherited from the previous meter when absent.
+func addMeters(set *prometheusremotewrite.SeriesSet, meters []*meter.MeterData, defaultTimestamp int64) {
+	var service, instance string
+	timestamp := defaultTimestamp
+	for _, m := range meters {
+		if m.GetService() != "" {
+			service, instance = m.GetService(), m.GetServiceInstance()
+		}
+		if m.GetTimestamp() > 0 {
+			timestamp = m.GetTimestamp()
+		}
+		if service == "" {
+			continue
+		}
+		if single := m.GetSingleValue(); single != nil {
+			set.Add(service, instance, single.GetName(), convertLabels(single.GetLabels()), single.GetValue(), timestamp)
+		} else if histogram := m.GetHistogram(); histogram != nil {
+			addHistogram(set, service, instance, histogram, timestamp)
+		}
+	}
+}
+
+// addHistogram converts the SkyWalking buckets keyed by the lower bound to the cumulative buckets keyed by the upper bound.
+func addHistogram(set *prometheusremotewrite.SeriesSet, service, instance string, histogram *meter.MeterHistogram, timestamp int64) {
+	values := make([]*meter.MeterBucketValue, len(histogram.GetValues()))
+	copy(values, histogram.GetValues())
+	sort.Slice(values, func(i, j int) bool {
+		return lowerBound(values[i]) < lowerBound(values[j])
+	})
+	buckets := make([]prometheusremotewrite.Bucket, 0, len(values))
+	var count float64
+	for i, v := range values {
+		count += float64(v.GetCount())
+		if i+1 < len(values) {
+			buckets = append(buckets, prometheusremotewrite.Bucket{UpperBound: lowerBound(values[i+1]), Count: count})
+		}
+	}
+	set.AddHistogram(service, instance, histogram.GetName(), convertLabels(histogram.GetLabels()), buckets, count, timestamp)
+}
+
+func lowerBound(v *meter.MeterBucketValue) float64 {
+	if v.GetIsNegativeInfinity() {
+		return math.Inf(-1)
+	}
+	return v.GetBucket()
+}
+
+func convertLabels(labels []*meter.Label) map[string]string {
+	result := make(map[string]string, len(labels))
+	for _, l := range labels {
+		result[l.GetName()] = l.GetValue()
+	}
+	return result
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_MeterType
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,114 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativemeter
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+func TestForwardMeters(t *testing.T) {
+	log.Init(&log.LoggerConfig{})
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		data, _ := snappy.Decode(nil, body)
+		req := &prompb.WriteRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, ts := range req.GetTimeseries() {
+			labels := make([]string, 0)
+			for _, l := range ts.GetLabels() {
+				labels = append(labels, l.GetName()+"="+l.GetValue())
+			}
+			for _, s := range ts.GetSamples() {
+				received = append(received, strings.Join(labels, ",")+" "+strconv.FormatFloat(s.GetValue(), 'g', -1, 64)+
+					" "+strconv.FormatInt(s.GetTimestamp(), 10))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	client := &http_client.Client{ServerAddr: server.URL, Timeout: "5s", CheckPeriod: 5}
+	if err := client.Prepare(); err != nil {
+		t.Fatalf("cannot prepare the http client: %v", err)
+	}
+	f := &Forwarder{WriteConfig: prometheusremotewrite.WriteConfig{
+		URI:                  "/api/v1/write",
+		ServiceLabel:         "job",
+		InstanceLabel:        "instance",
+		MaxSamplesPerRequest: 100,
+	}}
+	if err := f.Prepare(client.GetConnectedClient()); err != nil {
+		t.Fatalf("cannot prepare the forwarder: %v", err)
+	}
+
+	// the service, instance and timestamp are only reported by the first meter.
+	collection := &meter.MeterDataCollection{MeterData: []*meter.MeterData{
+		{
+			Service: "gateway", ServiceInstance: "gateway-1", Timestamp: 1000,
+			Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+				Name: "pool_size", Labels: []*meter.Label{{Name: "pool", Value: "db"}}, Value: 8,
+			}},
+		},
+		{
+			Metric: &meter.MeterData_Histogram{Histogram: &meter.MeterHistogram{
+				Name: "latency",
+				Values: []*meter.MeterBucketValue{
+					{Bucket: 10, Count: 2},
+					{IsNegativeInfinity: true, Count: 1},
+					{Bucket: 50, Count: 4},
+				},
+			}},
+		},
+	}}
+	batch := event.BatchEvents{&v1.SniffData{Timestamp: 2000, Data: &v1.SniffData_MeterCollection{MeterCollection: collection}}}
+	if err := f.Forward(batch); err != nil {
+		t.Fatalf("expected the meters are forwarded, but got: %v", err)
+	}
+	sort.Strings(received)
+	want := []string{
+		"__name__=latency_bucket,instance=gateway-1,job=gateway,le=+Inf 7 1000",
+		"__name__=latency_bucket,instance=gateway-1,job=gateway,le=10 1 1000",
+		"__name__=latency_bucket,instance=gateway-1,job=gateway,le=50 3 1000",
+		"__name__=latency_count,instance=gateway-1,job=gateway 7 1000",
+		"__name__=pool_size,instance=gateway-1,job=gateway,pool=db 8 1000",
+	}
+	if strings.Join(received, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("expected the series:\n%s\nbut got:\n%s", strings.Join(want, "\n"), strings.Join(received, "\n"))
+	}
+}
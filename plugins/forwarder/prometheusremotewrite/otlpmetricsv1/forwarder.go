@@ -0,0 +1,260 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlpmetricsv1
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite"
+
+	otlpcommon "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	otlpmetrics "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/metrics/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "otlp-metrics-v1-prometheus-remote-write-forwarder"
+	ShowName = "OpenTelemetry Metrics v1 Prometheus Remote Write Forwarder"
+)
+
+// The resource attributes of the service and the service instance.
+const (
+	serviceNameAttribute       = "service.name"
+	serviceInstanceIDAttribute = "service.instance.id"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	prometheusremotewrite.WriteConfig `mapstructure:",squash"`
+	ResourceLabels                    []string `mapstructure:"resource_labels"` // The resource attributes mapped to the labels.
+
+	writer *prometheusremotewrite.Writer
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization forwarder to send the OpenTelemetry metrics v1 to the Prometheus remote write endpoint. " +
+		"The \"service.name\" and \"service.instance.id\" resource attributes are the service and the service instance. " +
+		"The gauges, cumulative sums, cumulative histograms and summaries are supported, the monotonic sums have the \"_total\" suffix. " +
+		"The delta metrics and the exponential histograms are dropped as Prometheus couldn't accept them."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The resource attributes mapped to the labels, such as "k8s.namespace.name".
+resource_labels: []` + prometheusremotewrite.DefaultWriteConfig
+}
+
+func (f *Forwarder) Prepare(connection interface{}) error {
+	client, ok := connection.(*http_client.Connection)
+	if !ok {
+		return fmt.Errorf("the %s only accepts a http client, but received a %s",
+			f.Name(), reflect.TypeOf(connection).String())
+	}
+	writer, err := prometheusremotewrite.NewWriter(f.Name(), &f.WriteConfig, client)
+	if err != nil {
+		return err
+	}
+	f.writer = writer
+	return nil
+}
+
+func (f *Forwarder) Forward(batch event.BatchEvents) error {
+	set := f.writer.NewSeriesSet()
+	for _, e := range batch {
+		data, ok := e.GetData().(*v1.SniffData_OpenTelementryMetricsV1Request)
+		if !ok {
+			continue
+		}
+		for _, rm := range data.OpenTelementryMetricsV1Request.GetResourceMetrics() {
+			f.addResourceMetrics(set, rm, e.GetTimestamp())
+		}
+	}
+	if set.Len() == 0 {
+		return nil
+	}
+	return f.writer.Write(set)
+}
+
+func (f *Forwarder) addResourceMetrics(set *prometheusremotewrite.SeriesSet, rm *otlpmetrics.ResourceMetrics, defaultTimestamp int64) {
+	attributes := convertAttributes(rm.GetResource().GetAttributes())
+	service, instance := attributes[serviceNameAttribute], attributes[serviceInstanceIDAttribute]
+	if service == "" {
+		return
+	}
+	resourceLabels := make(map[string]string, len(f.ResourceLabels))
+	for _, key := range f.ResourceLabels {
+		if v, ok := attributes[key]; ok {
+			resourceLabels[key] = v
+		}
+	}
+	c := &converter{set: set, service: service, instance: instance, resourceLabels: resourceLabels, defaultTimestamp: defaultTimestamp}
+	for _, sm := range rm.GetScopeMetrics() {
+		for _, m := range sm.GetMetrics() {
+			c.addMetric(m)
+		}
+	}
+}
+
+type converter struct {
+	set              *prometheusremotewrite.SeriesSet
+	service          string
+	instance         string
+	resourceLabels   map[string]string
+	defaultTimestamp int64
+}
+
+func (c *converter) addMetric(m *otlpmetrics.Metric) {
+	switch {
+	case m.GetGauge() != nil:
+		c.addNumberDataPoints(m.GetName(), m.GetGauge().GetDataPoints())
+	case m.GetSum() != nil:
+		sum := m.GetSum()
+		if sum.GetAggregationTemporality() != otlpmetrics.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE {
+			return
+		}
+		name := m.GetName()
+		if sum.GetIsMonotonic() && !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+		c.addNumberDataPoints(name, sum.GetDataPoints())
+	case m.GetHistogram() != nil:
+		if m.GetHistogram().GetAggregationTemporality() != otlpmetrics.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE {
+			return
+		}
+		for _, p := range m.GetHistogram().GetDataPoints() {
+			c.addHistogramDataPoint(m.GetName(), p)
+		}
+	case m.GetSummary() != nil:
+		for _, p := range m.GetSummary().GetDataPoints() {
+			c.addSummaryDataPoint(m.GetName(), p)
+		}
+	}
+}
+
+func (c *converter) addNumberDataPoints(name string, points []*otlpmetrics.NumberDataPoint) {
+	for _, p := range points {
+		if noRecordedValue(p.GetFlags()) {
+			continue
+		}
+		value := p.GetAsDouble()
+		if v, ok := p.GetValue().(*otlpmetrics.NumberDataPoint_AsInt); ok {
+			value = float64(v.AsInt)
+		}
+		c.set.Add(c.service, c.instance, name, c.labels(p.GetAttributes()), value, c.timestamp(p.GetTimeUnixNano()))
+	}
+}
+
+func (c *converter) addHistogramDataPoint(name string, p *otlpmetrics.HistogramDataPoint) {
+	if noRecordedValue(p.GetFlags()) {
+		return
+	}
+	labels, timestamp := c.labels(p.GetAttributes()), c.timestamp(p.GetTimeUnixNano())
+	// the bucket counts are not cumulative, and the last bucket is the +Inf bucket.
+	buckets := make([]prometheusremotewrite.Bucket, 0, len(p.GetExplicitBounds()))
+	var count uint64
+	for i, bound := range p.GetExplicitBounds() {
+		if i < len(p.GetBucketCounts()) {
+			count += p.GetBucketCounts()[i]
+		}
+		buckets = append(buckets, prometheusremotewrite.Bucket{UpperBound: bound, Count: float64(count)})
+	}
+	c.set.AddHistogram(c.service, c.instance, name, labels, buckets, float64(p.GetCount()), timestamp)
+	if p.Sum != nil {
+		c.set.Add(c.service, c.instance, name+"_sum", labels, p.GetSum(), timestamp)
+	}
+}
+
+func (c *converter) addSummaryDataPoint(name string, p *otlpmetrics.SummaryDataPoint) {
+	if noRecordedValue(p.GetFlags()) {
+		return
+	}
+	labels, timestamp := c.labels(p.GetAttributes()), c.timestamp(p.GetTimeUnixNano())
+	for _, q := range p.GetQuantileValues() {
+		quantile := prometheusremotewrite.WithLabel(labels, "quantile", prometheusremotewrite.FormatFloat(q.GetQuantile()))
+		c.set.Add(c.service, c.instance, name, quantile, q.GetValue(), timestamp)
+	}
+	c.set.Add(c.service, c.instance, name+"_sum", labels, p.GetSum(), timestamp)
+	c.set.Add(c.service, c.instance, name+"_count", labels, float64(p.GetCount()), timestamp)
+}
+
+func (c *converter) labels(attributes []*otlpcommon.KeyValue) map[string]string {
+	labels := convertAttributes(attributes)
+	for k, v := range c.resourceLabels {
+		if _, ok := labels[k]; !ok {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+func (c *converter) timestamp(unixNano uint64) int64 {
+	if unixNano == 0 {
+		return c.defaultTimestamp
+	}
+	return int64(unixNano / 1e6)
+}
+
+func noRecordedValue(flags uint32) bool {
+	return flags&uint32(otlpmetrics.DataPointFlags_FLAG_NO_RECORDED_VALUE) != 0
+}
+
+// convertAttributes converts the attributes having the scalar values to the labels.
+func convertAttributes(attributes []*otlpcommon.KeyValue) map[string]string {
+	result := make(map[string]string, len(attributes))
+	for _, kv := range attributes {
+		switch v := kv.GetValue().GetValue().(type) {
+		case *otlpcommon.AnyValue_StringValue:
+			result[kv.GetKey()] = v.StringValue
+		case *otlpcommon.AnyValue_BoolValue:
+			result[kv.GetKey()] = strconv.FormatBool(v.BoolValue)
+		case *otlpcommon.AnyValue_IntValue:
+			result[kv.GetKey()] = strconv.FormatInt(v.IntValue, 10)
+		case *otlpcommon.AnyValue_DoubleValue:
+			result[kv.GetKey()] = strconv.FormatFloat(v.DoubleValue, 'f', -1, 64)
+		}
+	}
+	return result
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return v1.SniffType_OpenTelementryMetricsV1Type
+}
+
+func (f *Forwarder) SyncForward(*v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return nil, nil, fmt.Errorf("unsupport sync forward")
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return false
+}
@@ -0,0 +1,205 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+)
+
+const metricNameLabel = "__name__"
+
+// SeriesSet collects the samples, and merges the samples of the same series.
+type SeriesSet struct {
+	serviceLabel  string
+	instanceLabel string
+	external      []*prompb.Label
+
+	index  map[string]*prompb.TimeSeries
+	series []*prompb.TimeSeries
+}
+
+func newSeriesSet(serviceLabel, instanceLabel string, external []*prompb.Label) *SeriesSet {
+	return &SeriesSet{
+		serviceLabel:  serviceLabel,
+		instanceLabel: instanceLabel,
+		external:      external,
+		index:         make(map[string]*prompb.TimeSeries),
+	}
+}
+
+// Add adds a sample of the metric, the timestamp is in milliseconds. The metric name and the label names
+// are sanitized, the service labels override the labels of the metric, and the external labels don't.
+func (s *SeriesSet) Add(service, instance, name string, labels map[string]string, value float64, timestamp int64) {
+	merged := make(map[string]string, len(labels)+len(s.external)+3)
+	for k, v := range labels {
+		merged[sanitizeLabelName(k)] = v
+	}
+	merged[s.serviceLabel] = service
+	if s.instanceLabel != "" {
+		merged[s.instanceLabel] = instance
+	}
+	for _, l := range s.external {
+		if _, ok := merged[l.GetName()]; !ok {
+			merged[l.GetName()] = l.GetValue()
+		}
+	}
+	merged[metricNameLabel] = sanitizeMetricName(name)
+
+	series := make([]*prompb.Label, 0, len(merged))
+	for k, v := range merged {
+		// the empty label is the same as the absent label in Prometheus.
+		if v == "" {
+			continue
+		}
+		series = append(series, &prompb.Label{Name: k, Value: v})
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].GetName() < series[j].GetName()
+	})
+	var key strings.Builder
+	for _, l := range series {
+		key.WriteString(l.GetName())
+		key.WriteByte(0xff)
+		key.WriteString(l.GetValue())
+		key.WriteByte(0xff)
+	}
+	ts, ok := s.index[key.String()]
+	if !ok {
+		ts = &prompb.TimeSeries{Labels: series}
+		s.index[key.String()] = ts
+		s.series = append(s.series, ts)
+	}
+	ts.Samples = append(ts.Samples, &prompb.Sample{Value: value, Timestamp: timestamp})
+}
+
+// Bucket is a bucket of the histogram, the count is cumulative.
+type Bucket struct {
+	UpperBound float64
+	Count      float64
+}
+
+// AddHistogram adds the "_bucket" and "_count" series of a histogram, the buckets are sorted by the upper bound,
+// and the "+Inf" bucket is added when it is absent. The "_sum" series should be added by the caller when it is known.
+func (s *SeriesSet) AddHistogram(service, instance, name string, labels map[string]string, buckets []Bucket, count float64, timestamp int64) {
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].UpperBound < buckets[j].UpperBound
+	})
+	if len(buckets) == 0 || !math.IsInf(buckets[len(buckets)-1].UpperBound, 1) {
+		buckets = append(buckets, Bucket{UpperBound: math.Inf(1), Count: count})
+	}
+	for _, b := range buckets {
+		s.Add(service, instance, name+"_bucket", WithLabel(labels, "le", FormatFloat(b.UpperBound)), b.Count, timestamp)
+	}
+	s.Add(service, instance, name+"_count", labels, count, timestamp)
+}
+
+// Len returns the series count of the set.
+func (s *SeriesSet) Len() int {
+	return len(s.series)
+}
+
+// split sorts the samples of every series by the timestamp, the later sample wins when the timestamps
+// are the same, then splits the series into the groups with at most max samples. The series having
+// more samples than max is a single group.
+func (s *SeriesSet) split(max int) [][]*prompb.TimeSeries {
+	groups := make([][]*prompb.TimeSeries, 0)
+	group := make([]*prompb.TimeSeries, 0)
+	count := 0
+	for _, ts := range s.series {
+		sort.SliceStable(ts.Samples, func(i, j int) bool {
+			return ts.Samples[i].GetTimestamp() < ts.Samples[j].GetTimestamp()
+		})
+		samples := ts.Samples[:0]
+		for i, sample := range ts.Samples {
+			if i+1 < len(ts.Samples) && ts.Samples[i+1].GetTimestamp() == sample.GetTimestamp() {
+				continue
+			}
+			samples = append(samples, sample)
+		}
+		ts.Samples = samples
+
+		if count > 0 && count+len(ts.Samples) > max {
+			groups = append(groups, group)
+			group, count = make([]*prompb.TimeSeries, 0), 0
+		}
+		group = append(group, ts)
+		count += len(ts.Samples)
+	}
+	if len(group) > 0 {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// sanitizeMetricName replaces the characters not matching [a-zA-Z0-9_:] with "_",
+// and adds the "_" prefix when the name starts with a digit.
+func sanitizeMetricName(name string) string {
+	return sanitize(name, true)
+}
+
+// sanitizeLabelName replaces the characters not matching [a-zA-Z0-9_] with "_",
+// and adds the "_" prefix when the name starts with a digit.
+func sanitizeLabelName(name string) string {
+	return sanitize(name, false)
+}
+
+func sanitize(name string, allowColon bool) string {
+	var b strings.Builder
+	b.Grow(len(name) + 1)
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == ':' && allowColon:
+			b.WriteRune(c)
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				b.WriteByte('_')
+			}
+			b.WriteRune(c)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// WithLabel returns a copy of the labels with the additional label, such as the "le" or the "quantile".
+func WithLabel(labels map[string]string, name, value string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	result[name] = value
+	return result
+}
+
+// FormatFloat formats the bucket bound or the quantile as the label value in the Prometheus way.
+func FormatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
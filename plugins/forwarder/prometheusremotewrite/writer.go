@@ -0,0 +1,133 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+)
+
+const remoteWriteVersion = "0.1.0"
+
+// WriteConfig is the common config of the Prometheus remote write forwarders.
+type WriteConfig struct {
+	URI                  string            `mapstructure:"uri"`                     // The remote write URI.
+	ServiceLabel         string            `mapstructure:"service_label"`           // The label name of the service.
+	InstanceLabel        string            `mapstructure:"instance_label"`          // The label name of the service instance.
+	ExternalLabels       map[string]string `mapstructure:"external_labels"`         // The labels attached to every series.
+	MaxSamplesPerRequest int               `mapstructure:"max_samples_per_request"` // The max samples count of a write request.
+}
+
+// DefaultWriteConfig is the default config of the WriteConfig.
+const DefaultWriteConfig = `
+# The remote write URI, such as "/api/v1/push" of Mimir or "/api/v1/receive" of Thanos.
+uri: "/api/v1/write"
+# The label name of the service.
+service_label: "job"
+# The label name of the service instance, the instance is not a label when it is empty.
+instance_label: "instance"
+# The labels attached to every series, such as the cluster. The labels of the series are not overridden.
+external_labels: {}
+# The max samples count of a write request, the batch is split into multiple requests when it has more samples.
+max_samples_per_request: 2000
+`
+
+// Writer sends the series to the remote write endpoint.
+type Writer struct {
+	name       string
+	config     *WriteConfig
+	connection *http_client.Connection
+	external   []*prompb.Label
+}
+
+// NewWriter creates a Writer of the forwarder.
+func NewWriter(name string, config *WriteConfig, connection *http_client.Connection) (*Writer, error) {
+	if config.ServiceLabel == "" {
+		return nil, fmt.Errorf("the service label is required")
+	}
+	if config.MaxSamplesPerRequest <= 0 {
+		return nil, fmt.Errorf("the max samples per request must be positive")
+	}
+	external := make([]*prompb.Label, 0, len(config.ExternalLabels))
+	for k, v := range config.ExternalLabels {
+		external = append(external, &prompb.Label{Name: sanitizeLabelName(k), Value: v})
+	}
+	return &Writer{
+		name:       name,
+		config:     config,
+		connection: connection,
+		external:   external,
+	}, nil
+}
+
+// NewSeriesSet creates an empty SeriesSet with the service labels and the external labels of the writer.
+func (w *Writer) NewSeriesSet() *SeriesSet {
+	return newSeriesSet(sanitizeLabelName(w.config.ServiceLabel), sanitizeLabelName(w.config.InstanceLabel), w.external)
+}
+
+// Write sends the series of the set, the error would be returned when the remote write endpoint
+// responds 429 or 5xx, so the batch could be retried by the fallbacker.
+func (w *Writer) Write(set *SeriesSet) error {
+	for _, series := range set.split(w.config.MaxSamplesPerRequest) {
+		data, err := proto.Marshal(&prompb.WriteRequest{Timeseries: series})
+		if err != nil {
+			return err
+		}
+		if err := w.send(snappy.Encode(nil, data), len(series)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) send(body []byte, count int) error {
+	req, err := w.connection.NewRequest(context.Background(), http.MethodPost, w.config.URI, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+	resp, err := w.connection.Do(req)
+	if err != nil {
+		log.Logger.Errorf("%s remote write error: %v", w.name, err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode/100 == 5 {
+		return fmt.Errorf("%s remote write failure, status code: %d, response: %s", w.name, resp.StatusCode, msg)
+	}
+	// the request would not be accepted by retrying, such as the out of order or the duplicated samples.
+	log.Logger.Warnf("%s drop %d series rejected by the remote write endpoint, status code: %d, response: %s",
+		w.name, count, resp.StatusCode, msg)
+	return nil
+}
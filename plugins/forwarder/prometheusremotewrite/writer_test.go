@@ -0,0 +1,178 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/snappy"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	http_client "github.com/apache/skywalking-satellite/plugins/client/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+)
+
+// remoteWriteStandIn is a local stand-in of the remote write endpoint, which responds the configured status.
+type remoteWriteStandIn struct {
+	lock     sync.Mutex
+	status   int
+	requests []*prompb.WriteRequest
+}
+
+func (s *remoteWriteStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if r.Header.Get("Content-Encoding") != "snappy" || r.Header.Get("X-Prometheus-Remote-Write-Version") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	data, err := snappy.Decode(nil, body)
+	req := &prompb.WriteRequest{}
+	if err != nil || proto.Unmarshal(data, req) != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, req)
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newWriter(t *testing.T, standIn http.Handler, maxSamples int) *Writer {
+	log.Init(&log.LoggerConfig{})
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+	client := &http_client.Client{ServerAddr: server.URL, Timeout: "5s", CheckPeriod: 5}
+	if err := client.Prepare(); err != nil {
+		t.Fatalf("cannot prepare the http client: %v", err)
+	}
+	writer, err := NewWriter("test", &WriteConfig{
+		URI:                  "/api/v1/write",
+		ServiceLabel:         "job",
+		InstanceLabel:        "instance",
+		ExternalLabels:       map[string]string{"cluster": "east", "region": "cn"},
+		MaxSamplesPerRequest: maxSamples,
+	}, client.GetConnectedClient().(*http_client.Connection))
+	if err != nil {
+		t.Fatalf("cannot create the remote write writer: %v", err)
+	}
+	return writer
+}
+
+func labelsString(ts *prompb.TimeSeries) string {
+	result := make([]string, 0, len(ts.GetLabels()))
+	for _, l := range ts.GetLabels() {
+		result = append(result, l.GetName()+"="+l.GetValue())
+	}
+	return strings.Join(result, ",")
+}
+
+func TestWriteSeries(t *testing.T) {
+	standIn := &remoteWriteStandIn{}
+	writer := newWriter(t, standIn, 100)
+	set := writer.NewSeriesSet()
+	set.Add("gateway", "gateway-1", "http.requests", map[string]string{"status.code": "200", "region": "us"}, 3, 2000)
+	set.Add("gateway", "gateway-1", "http.requests", map[string]string{"region": "us", "status.code": "200"}, 1, 1000)
+	set.Add("gateway", "gateway-1", "http.requests", map[string]string{"region": "us", "status.code": "200"}, 2, 1000)
+	set.Add("gateway", "", "1st_metric", map[string]string{"job": "overridden"}, 5, 1000)
+	if err := writer.Write(set); err != nil {
+		t.Fatalf("expected the series are written, but got: %v", err)
+	}
+	if len(standIn.requests) != 1 || len(standIn.requests[0].GetTimeseries()) != 2 {
+		t.Fatalf("expected 1 request with 2 series, but got %v", standIn.requests)
+	}
+	series := standIn.requests[0].GetTimeseries()
+	want := "__name__=http_requests,cluster=east,instance=gateway-1,job=gateway,region=us,status_code=200"
+	if got := labelsString(series[0]); got != want {
+		t.Errorf("expected the labels %s, but got %s", want, got)
+	}
+	if samples := series[0].GetSamples(); len(samples) != 2 || samples[0].GetValue() != 2 || samples[1].GetValue() != 3 {
+		t.Errorf("expected the samples are sorted and deduplicated, but got %v", samples)
+	}
+	if got, want := labelsString(series[1]), "__name__=_1st_metric,cluster=east,job=gateway,region=cn"; got != want {
+		t.Errorf("expected the labels %s, but got %s", want, got)
+	}
+}
+
+func TestWriteHistogram(t *testing.T) {
+	standIn := &remoteWriteStandIn{}
+	writer := newWriter(t, standIn, 100)
+	set := writer.NewSeriesSet()
+	set.AddHistogram("gateway", "", "latency", nil, []Bucket{{UpperBound: 50, Count: 3}, {UpperBound: 10, Count: 1}}, 4, 1000)
+	if err := writer.Write(set); err != nil {
+		t.Fatalf("expected the series are written, but got: %v", err)
+	}
+	series := standIn.requests[0].GetTimeseries()
+	want := []string{
+		"__name__=latency_bucket,cluster=east,job=gateway,le=10,region=cn 1",
+		"__name__=latency_bucket,cluster=east,job=gateway,le=50,region=cn 3",
+		"__name__=latency_bucket,cluster=east,job=gateway,le=+Inf,region=cn 4",
+		"__name__=latency_count,cluster=east,job=gateway,region=cn 4",
+	}
+	if len(series) != len(want) {
+		t.Fatalf("expected %d series, but got %d", len(want), len(series))
+	}
+	for i, ts := range series {
+		if got := labelsString(ts) + " " + FormatFloat(ts.GetSamples()[0].GetValue()); got != want[i] {
+			t.Errorf("expected the series %s, but got %s", want[i], got)
+		}
+	}
+}
+
+func TestSplitRequests(t *testing.T) {
+	standIn := &remoteWriteStandIn{}
+	writer := newWriter(t, standIn, 2)
+	set := writer.NewSeriesSet()
+	for _, name := range []string{"a", "b", "c"} {
+		set.Add("gateway", "", name, nil, 1, 1000)
+	}
+	if err := writer.Write(set); err != nil {
+		t.Fatalf("expected the series are written, but got: %v", err)
+	}
+	if len(standIn.requests) != 2 || len(standIn.requests[0].GetTimeseries()) != 2 || len(standIn.requests[1].GetTimeseries()) != 1 {
+		t.Fatalf("expected the series are split into 2 requests, but got %v", standIn.requests)
+	}
+}
+
+func TestWriteFailure(t *testing.T) {
+	tests := []struct {
+		status  int
+		wantErr bool
+	}{
+		{status: http.StatusTooManyRequests, wantErr: true},
+		{status: http.StatusInternalServerError, wantErr: true},
+		{status: http.StatusBadRequest, wantErr: false},
+	}
+	for _, tt := range tests {
+		writer := newWriter(t, &remoteWriteStandIn{status: tt.status}, 100)
+		set := writer.NewSeriesSet()
+		set.Add("gateway", "", "a", nil, 1, 1000)
+		if err := writer.Write(set); (err != nil) != tt.wantErr {
+			t.Errorf("status %d: expected error %v, but got %v", tt.status, tt.wantErr, err)
+		}
+	}
+}
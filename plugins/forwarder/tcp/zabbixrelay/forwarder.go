@@ -0,0 +1,109 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbixrelay
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	zabbix_server "github.com/apache/skywalking-satellite/plugins/server/zabbix"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "zabbix-relay-tcp-forwarder"
+	ShowName = "Zabbix Relay TCP Forwarder"
+)
+
+type Forwarder struct {
+	config.CommonFields
+	ServerAddr    string `mapstructure:"server_addr"`     // The address of the OAP Zabbix receiver.
+	Timeout       int    `mapstructure:"timeout"`         // The dial, read and write timeout seconds of a relayed request.
+	MaxPacketSize int    `mapstructure:"max_packet_size"` // The max bytes of an uncompressed response packet.
+}
+
+func (f *Forwarder) Name() string {
+	return Name
+}
+
+func (f *Forwarder) ShowName() string {
+	return ShowName
+}
+
+func (f *Forwarder) Description() string {
+	return "This is a synchronization TCP forwarder relaying the Zabbix agent requests to the OAP Zabbix receiver, " +
+		"and the responses are returned to the agents as they are. The forwarder connects to the server address directly " +
+		"for every request as the Zabbix protocol does, so the client of the pipe is not used."
+}
+
+func (f *Forwarder) DefaultConfig() string {
+	return `
+# The address of the OAP Zabbix receiver.
+server_addr: "localhost:10051"
+# The dial, read and write timeout seconds of a relayed request.
+timeout: 10
+# The max bytes of an uncompressed response packet.
+max_packet_size: 16777216
+`
+}
+
+func (f *Forwarder) Prepare(_ interface{}) error {
+	if f.ServerAddr == "" {
+		return fmt.Errorf("the server_addr of the %s is required", f.Name())
+	}
+	return nil
+}
+
+func (f *Forwarder) Forward(_ event.BatchEvents) error {
+	return fmt.Errorf("the Zabbix relay is not support async forward")
+}
+
+func (f *Forwarder) ForwardType() v1.SniffType {
+	return event.ZabbixRequestType
+}
+
+func (f *Forwarder) SyncForward(e *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	if e.GetType() != event.ZabbixRequestType {
+		return nil, nil, fmt.Errorf("unsupport data")
+	}
+	timeout := time.Duration(f.Timeout) * time.Second
+	conn, err := net.DialTimeout("tcp", f.ServerAddr, timeout)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot connect to the zabbix server %s: %v", f.ServerAddr, err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+	if err := zabbix_server.WritePacket(conn, event.ExtensionData(e)); err != nil {
+		return nil, nil, fmt.Errorf("cannot relay the zabbix request: %v", err)
+	}
+	response, err := zabbix_server.ReadPacket(conn, f.MaxPacketSize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read the zabbix response: %v", err)
+	}
+	return event.NewExtensionEvent(e.GetName(), event.ZabbixRequestType, response), nil, nil
+}
+
+func (f *Forwarder) SupportedSyncInvoke() bool {
+	return true
+}
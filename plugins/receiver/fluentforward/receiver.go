@@ -0,0 +1,291 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	grpc_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	forward_server "github.com/apache/skywalking-satellite/plugins/server/fluentforward"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "fluent-forward-receiver"
+	ShowName  = "Fluent Forward Receiver"
+	eventName = "fluent-forward-event"
+
+	levelTagKey = "level"
+	tagTagKey   = "fluent.tag"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	ServiceField  string `mapstructure:"service_field"`  // The record field of the service name, the fluent tag is used when the field is absent.
+	InstanceField string `mapstructure:"instance_field"` // The record field of the service instance name.
+	ContentField  string `mapstructure:"content_field"`  // The record field of the log content, the whole record is the JSON content when the field is absent.
+	LevelField    string `mapstructure:"level_field"`    // The record field of the log level.
+	TraceIDField  string `mapstructure:"trace_id_field"` // The record field of the trace id.
+	Layer         string `mapstructure:"layer"`          // The layer of the service, the OAP would treat it as the general layer when it is empty.
+	// components
+	Server        *forward_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the Fluent Forward protocol, which converts the records sent by the forward output of the Fluent Bit or the Fluentd " +
+		"to the SkyWalking native logs. The fields are located by the configured field names, the nested fields could be located by the dot " +
+		"separated path such as kubernetes.labels.app, and the other fields are flattened to the log tags."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The record field of the service name, the fluent tag is used when the field is absent.
+service_field: "kubernetes.labels.app"
+# The record field of the service instance name.
+instance_field: "kubernetes.pod_name"
+# The record field of the log content, the whole record is the JSON content when the field is absent.
+content_field: "log"
+# The record field of the log level.
+level_field: "level"
+# The record field of the trace id.
+trace_id_field: "trace_id"
+# The layer of the service, the OAP would treat it as the general layer when it is empty.
+layer: ""
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*forward_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.RegisterHandler(r.handleEntries)
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) handleEntries(tag string, entries []*forward_server.Entry, remote net.Addr) {
+	logs := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		data, err := proto.Marshal(r.convertToNativeLog(tag, entry))
+		if err != nil {
+			log.Logger.Warnf("%s marshal the native log failure: %v", r.Name(), err)
+			continue
+		}
+		logs = append(logs, data)
+	}
+	if len(logs) == 0 {
+		return
+	}
+	r.OutputChannel <- &v1.SniffData{
+		Name:      eventName,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Meta:      event.SourcePeerMeta(remote.String()),
+		Type:      v1.SniffType_Logging,
+		Remote:    true,
+		Data: &v1.SniffData_LogList{
+			LogList: &v1.BatchLogList{
+				Logs: logs,
+			},
+		},
+	}
+}
+
+func (r *Receiver) convertToNativeLog(tag string, entry *forward_server.Entry) *logging.LogData {
+	service := fieldString(entry.Record, r.ServiceField)
+	if service == "" {
+		service = tag
+	}
+	excluded := map[string]bool{r.ServiceField: true, r.InstanceField: true, r.ContentField: true, r.LevelField: true, r.TraceIDField: true}
+	tags := []*common.KeyStringValuePair{{Key: tagTagKey, Value: tag}}
+	if level := fieldString(entry.Record, r.LevelField); level != "" {
+		tags = append(tags, &common.KeyStringValuePair{Key: levelTagKey, Value: level})
+	}
+	tags = flattenTags(tags, "", entry.Record, excluded)
+
+	data := &logging.LogData{
+		Timestamp:       entry.Time.UnixNano() / 1e6,
+		Service:         service,
+		ServiceInstance: fieldString(entry.Record, r.InstanceField),
+		Tags:            &logging.LogTags{Data: tags},
+		Layer:           r.Layer,
+	}
+	if content, ok := field(entry.Record, r.ContentField); ok {
+		data.Body = &logging.LogDataBody{
+			Type:    "text",
+			Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: strings.TrimRight(toString(content), "\n")}},
+		}
+	} else {
+		data.Body = &logging.LogDataBody{
+			Type:    "json",
+			Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: toString(entry.Record)}},
+		}
+	}
+	if traceID := fieldString(entry.Record, r.TraceIDField); traceID != "" {
+		data.TraceContext = &logging.TraceContext{TraceId: traceID}
+	}
+	return data
+}
+
+// field finds the value of the field, the nested field is located by the dot separated path
+// when the record does not contain the whole path as a key.
+func field(record map[string]interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return nil, false
+	}
+	if v, ok := record[path]; ok {
+		return v, true
+	}
+	current := record
+	keys := strings.Split(path, ".")
+	for i, key := range keys {
+		v, ok := current[key]
+		if !ok {
+			return nil, false
+		}
+		if i == len(keys)-1 {
+			return v, true
+		}
+		if current, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func fieldString(record map[string]interface{}, path string) string {
+	if v, ok := field(record, path); ok {
+		return toString(v)
+	}
+	return ""
+}
+
+// flattenTags flattens the nested maps to the tags with the dot separated keys in the sorted order.
+func flattenTags(tags []*common.KeyStringValuePair, prefix string, record map[string]interface{}, excluded map[string]bool) []*common.KeyStringValuePair {
+	keys := make([]string, 0, len(record))
+	for k := range record {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		key := prefix + k
+		if excluded[key] {
+			continue
+		}
+		if nested, ok := record[k].(map[string]interface{}); ok {
+			tags = flattenTags(tags, key+".", nested, excluded)
+			continue
+		}
+		tags = append(tags, &common.KeyStringValuePair{Key: key, Value: toString(record[k])})
+	}
+	return tags
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(normalize(v))
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// normalize converts the binaries to the strings, as the Fluentd may send the strings as the binaries.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			result[k] = normalize(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			result = append(result, normalize(item))
+		}
+		return result
+	case *forward_server.ExtValue:
+		return nil
+	default:
+		return v
+	}
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(grpc_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
+		new(kafka_nativelog.Forwarder),
+	}
+}
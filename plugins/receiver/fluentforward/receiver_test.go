@@ -0,0 +1,242 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"net"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	forwardserver "github.com/apache/skywalking-satellite/plugins/server/fluentforward"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const testAddress = "localhost:24225"
+
+type eventTime time.Time
+
+func TestReceiver_MessageModeWithAck(t *testing.T) {
+	r := startReceiver(t)
+	conn := dial(t)
+	defer conn.Close()
+	record := map[string]interface{}{
+		"log":        "hello world\n",
+		"level":      "ERROR",
+		"stream":     "stderr",
+		"kubernetes": map[string]interface{}{"pod_name": "pod-1", "labels": map[string]interface{}{"app": "svc-a"}},
+	}
+	timestamp := time.Date(2024, 3, 1, 8, 0, 0, 123000000, time.UTC)
+	write(t, conn, []interface{}{"kube.var.log", eventTime(timestamp), record, map[string]interface{}{"chunk": "chunk-id"}})
+
+	logs := receiveLogs(t, r)
+	if len(logs) != 1 {
+		t.Fatalf("should receive 1 log, but got %d", len(logs))
+	}
+	l := logs[0]
+	if l.GetService() != "svc-a" || l.GetServiceInstance() != "pod-1" {
+		t.Fatalf("the service and instance should be svc-a and pod-1, but got %s and %s", l.GetService(), l.GetServiceInstance())
+	}
+	if l.GetTimestamp() != timestamp.UnixNano()/1e6 {
+		t.Fatalf("the timestamp should be %d, but got %d", timestamp.UnixNano()/1e6, l.GetTimestamp())
+	}
+	if l.GetBody().GetText().GetText() != "hello world" {
+		t.Fatalf("the content is not as expected: %s", l.GetBody().GetText().GetText())
+	}
+	tags := make(map[string]string)
+	for _, tag := range l.GetTags().GetData() {
+		tags[tag.GetKey()] = tag.GetValue()
+	}
+	expectedTags := map[string]string{"fluent.tag": "kube.var.log", "level": "ERROR", "stream": "stderr"}
+	if !reflect.DeepEqual(expectedTags, tags) {
+		t.Fatalf("the tags should be %v, but got %v", expectedTags, tags)
+	}
+
+	ack := encode(map[string]interface{}{"ack": "chunk-id"})
+	buf := make([]byte, len(ack))
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatalf("cannot read the ack: %v", err)
+	}
+	if !bytes.Equal(ack, buf) {
+		t.Fatalf("the ack is not as expected: %v", buf)
+	}
+}
+
+func TestReceiver_ForwardMode(t *testing.T) {
+	r := startReceiver(t)
+	conn := dial(t)
+	defer conn.Close()
+	write(t, conn, []interface{}{"app", []interface{}{
+		[]interface{}{int64(1709280000), map[string]interface{}{"log": "first"}},
+		[]interface{}{int64(1709280001), map[string]interface{}{"message": "second"}},
+	}})
+	logs := receiveLogs(t, r)
+	if len(logs) != 2 {
+		t.Fatalf("should receive 2 logs, but got %d", len(logs))
+	}
+	if logs[0].GetService() != "app" || logs[0].GetBody().GetText().GetText() != "first" || logs[0].GetTimestamp() != 1709280000000 {
+		t.Fatalf("the first log is not as expected: %v", logs[0])
+	}
+	if logs[1].GetBody().GetJson().GetJson() != `{"message":"second"}` {
+		t.Fatalf("the second log content should be the whole record, but got %v", logs[1].GetBody())
+	}
+}
+
+func TestReceiver_CompressedPackedForwardMode(t *testing.T) {
+	r := startReceiver(t)
+	conn := dial(t)
+	defer conn.Close()
+	var packed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&packed)
+	for _, content := range []string{"first", "second", "third"} {
+		_, _ = gzipWriter.Write(encode([]interface{}{int64(1709280000), map[string]interface{}{"log": []byte(content)}}))
+	}
+	_ = gzipWriter.Close()
+	write(t, conn, []interface{}{"app", packed.Bytes(), map[string]interface{}{"compressed": "gzip", "size": int64(3)}})
+	logs := receiveLogs(t, r)
+	if len(logs) != 3 {
+		t.Fatalf("should receive 3 logs, but got %d", len(logs))
+	}
+	if logs[2].GetBody().GetText().GetText() != "third" {
+		t.Fatalf("the third log content is not as expected: %v", logs[2].GetBody())
+	}
+}
+
+func receiveLogs(t *testing.T, r receiver.Receiver) []*logging.LogData {
+	var e *v1.SniffData
+	select {
+	case e = <-r.Channel():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("cannot receive the fluent forward event")
+	}
+	if e.GetType() != v1.SniffType_Logging {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_Logging, e.GetType())
+	}
+	result := make([]*logging.LogData, 0)
+	for _, data := range e.GetLogList().GetLogs() {
+		l := &logging.LogData{}
+		if err := proto.Unmarshal(data, l); err != nil {
+			t.Fatalf("cannot unmarshal the log: %v", err)
+		}
+		result = append(result, l)
+	}
+	return result
+}
+
+func dial(t *testing.T) net.Conn {
+	conn, err := net.Dial("tcp", testAddress)
+	if err != nil {
+		t.Fatalf("cannot dial the fluent forward server: %v", err)
+	}
+	return conn
+}
+
+func write(t *testing.T, conn net.Conn, value interface{}) {
+	if _, err := conn.Write(encode(value)); err != nil {
+		t.Fatalf("cannot write the message: %v", err)
+	}
+}
+
+// encode is a simple MessagePack encoder for the test values.
+func encode(value interface{}) []byte {
+	var buf []byte
+	switch v := value.(type) {
+	case string:
+		if len(v) < 32 {
+			buf = append(buf, 0xa0|byte(len(v)))
+		} else {
+			buf = append(buf, 0xdb)
+			buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		}
+		buf = append(buf, v...)
+	case []byte:
+		buf = append(buf, 0xc6)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		buf = append(buf, v...)
+	case int64:
+		buf = append(buf, 0xd3)
+		buf = binary.BigEndian.AppendUint64(buf, uint64(v))
+	case eventTime:
+		buf = append(buf, 0xd7, 0x00)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(time.Time(v).Unix()))
+		buf = binary.BigEndian.AppendUint32(buf, uint32(time.Time(v).Nanosecond()))
+	case []interface{}:
+		buf = append(buf, 0xdd)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		for _, item := range v {
+			buf = append(buf, encode(item)...)
+		}
+	case map[string]interface{}:
+		if len(v) < 16 {
+			buf = append(buf, 0x80|byte(len(v)))
+		} else {
+			buf = append(buf, 0xdf)
+			buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			buf = append(buf, encode(k)...)
+			buf = append(buf, encode(v[k])...)
+		}
+	}
+	return buf
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(forwardserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: forwardserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the fluent forward server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the fluent forward server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
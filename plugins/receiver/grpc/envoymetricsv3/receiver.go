@@ -23,6 +23,7 @@ import (
 	"github.com/apache/skywalking-satellite/internal/satellite/module/buffer"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/envoymetricsv3"
+	prometheusremotewrite_envoymetricsv3 "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/envoymetricsv3"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	v3 "skywalking.apache.org/repo/goapi/proto/envoy/service/metrics/v3"
@@ -81,5 +82,6 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(envoymetricsv3.Forwarder),
+		new(prometheusremotewrite_envoymetricsv3.Forwarder),
 	}
 }
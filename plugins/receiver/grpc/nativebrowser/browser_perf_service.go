@@ -0,0 +1,65 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativebrowser
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	"github.com/apache/skywalking-satellite/plugins/server/grpc"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	perfDataEventName = "grpc-browser-perf-data-event"
+	errorLogEventName = "grpc-browser-error-log-event"
+)
+
+type BrowserPerfService struct {
+	receiveChannel chan *v1.SniffData
+	agent.UnimplementedBrowserPerfServiceServer
+}
+
+func (s *BrowserPerfService) CollectPerfData(_ context.Context, data *agent.BrowserPerfData) (*common.Commands, error) {
+	content, err := proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	s.receiveChannel <- event.NewExtensionEvent(perfDataEventName, event.BrowserPerfDataType, content)
+	return &common.Commands{}, nil
+}
+
+func (s *BrowserPerfService) CollectErrorLogs(stream agent.BrowserPerfService_CollectErrorLogsServer) error {
+	for {
+		originalData := grpc.NewOriginalData(nil)
+		err := stream.RecvMsg(originalData)
+		if err == io.EOF {
+			return stream.SendAndClose(&common.Commands{})
+		}
+		if err != nil {
+			return err
+		}
+		s.receiveChannel <- event.NewExtensionEvent(errorLogEventName, event.BrowserErrorLogType, originalData.Content)
+	}
+}
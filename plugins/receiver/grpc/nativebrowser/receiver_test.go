@@ -0,0 +1,109 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativebrowser
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver_grpc "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
+)
+
+func TestReceiver_PerfData(t *testing.T) {
+	receiver_grpc.TestReceiver(new(Receiver), func(t *testing.T, sequence int, conn *grpc.ClientConn, ctx context.Context) string {
+		client := agent.NewBrowserPerfServiceClient(conn)
+		data := initPerfData(sequence)
+		if _, err := client.CollectPerfData(ctx, data); err != nil {
+			t.Fatalf("cannot send the data to the server: %v", err)
+		}
+		return data.String()
+	}, func(data *v1.SniffData) string {
+		if data.GetType() != event.BrowserPerfDataType {
+			return ""
+		}
+		perfData := &agent.BrowserPerfData{}
+		if err := proto.Unmarshal(event.ExtensionData(data), perfData); err != nil {
+			return ""
+		}
+		return perfData.String()
+	}, t)
+}
+
+func TestReceiver_ErrorLogs(t *testing.T) {
+	receiver_grpc.TestReceiver(new(Receiver), func(t *testing.T, sequence int, conn *grpc.ClientConn, ctx context.Context) string {
+		client := agent.NewBrowserPerfServiceClient(conn)
+		data := initErrorLog(sequence)
+		collect, err := client.CollectErrorLogs(ctx)
+		if err != nil {
+			t.Fatalf("cannot open the stream send mode: %v", err)
+		}
+		if err := collect.Send(data); err != nil {
+			t.Fatalf("cannot send the data to the server: %v", err)
+		}
+		if err := collect.CloseSend(); err != nil {
+			t.Fatalf("cannot close the stream mode: %v", err)
+		}
+		return data.String()
+	}, func(data *v1.SniffData) string {
+		if data.GetType() != event.BrowserErrorLogType {
+			return ""
+		}
+		errorLog := &agent.BrowserErrorLog{}
+		if err := proto.Unmarshal(event.ExtensionData(data), errorLog); err != nil {
+			return ""
+		}
+		return errorLog.String()
+	}, t)
+}
+
+func initPerfData(sequence int) *agent.BrowserPerfData {
+	seq := strconv.Itoa(sequence)
+	return &agent.BrowserPerfData{
+		Service:        "demo-web" + seq,
+		ServiceVersion: "v1.0.0",
+		Time:           time.Now().UnixNano() / 1e6,
+		PagePath:       "/index.html",
+		DnsTime:        10,
+		TtfbTime:       20,
+		LoadPageTime:   300,
+	}
+}
+
+func initErrorLog(sequence int) *agent.BrowserErrorLog {
+	seq := strconv.Itoa(sequence)
+	return &agent.BrowserErrorLog{
+		UniqueId:       "unique-id" + seq,
+		Service:        "demo-web" + seq,
+		ServiceVersion: "v1.0.0",
+		Time:           time.Now().UnixNano() / 1e6,
+		PagePath:       "/index.html",
+		Category:       agent.ErrorCategory_js,
+		Grade:          "Error",
+		Message:        "test message" + seq,
+	}
+}
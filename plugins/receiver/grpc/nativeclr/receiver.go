@@ -26,6 +26,7 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	forwarder_nativeclr "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeclr"
+	kafka_nativeclr "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeclr"
 	grpcreceiver "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 )
 
@@ -74,5 +75,6 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativeclr.Forwarder),
+		new(kafka_nativeclr.Forwarder),
 	}
 }
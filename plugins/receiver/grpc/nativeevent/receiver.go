@@ -22,6 +22,7 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	forwarder_nativeevent "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeevent"
+	kafka_nativeevent "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeevent"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	nativeevent "skywalking.apache.org/repo/goapi/collect/event/v3"
@@ -72,5 +73,6 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativeevent.Forwarder),
+		new(kafka_nativeevent.Forwarder),
 	}
 }
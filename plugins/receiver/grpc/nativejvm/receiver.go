@@ -26,6 +26,7 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	forwarder_nativejvm "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativejvm"
+	kafka_nativejvm "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativejvm"
 	grpcreceiver "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 )
 
@@ -74,5 +75,6 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativejvm.Forwarder),
+		new(kafka_nativejvm.Forwarder),
 	}
 }
@@ -21,6 +21,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
 	"github.com/apache/skywalking-satellite/plugins/server/grpc"
 
 	common "skywalking.apache.org/repo/goapi/collect/common/v3"
@@ -41,25 +42,25 @@ func (s *LogReportService) Collect(stream logging.LogReportService_CollectServer
 	for {
 		err := stream.RecvMsg(originalData)
 		if err == io.EOF {
-			s.flushLogs(dataList)
+			s.flushLogs(dataList, grpc.GetPeerAddressFromStreamContext(stream.Context()))
 			return stream.SendAndClose(&common.Commands{})
 		}
 		if err != nil {
-			s.flushLogs(dataList)
+			s.flushLogs(dataList, grpc.GetPeerAddressFromStreamContext(stream.Context()))
 			return err
 		}
 		dataList = append(dataList, originalData.Content)
 	}
 }
 
-func (s *LogReportService) flushLogs(dataList [][]byte) {
+func (s *LogReportService) flushLogs(dataList [][]byte, peer string) {
 	if len(dataList) == 0 {
 		return
 	}
 	e := &v1.SniffData{
 		Name:      eventName,
 		Timestamp: time.Now().UnixNano() / 1e6,
-		Meta:      nil,
+		Meta:      event.SourcePeerMeta(peer),
 		Type:      v1.SniffType_Logging,
 		Remote:    true,
 		Data: &v1.SniffData_LogList{
@@ -24,7 +24,10 @@ import (
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
 	forwarder_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
 	grpcreceiver "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 )
 
@@ -72,5 +75,8 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
 	}
 }
@@ -22,6 +22,7 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	forwarder_nativemanagement "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemanagement"
+	kafka_nativemanagement "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemanagement"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	v3 "skywalking.apache.org/repo/goapi/collect/management/v3"
@@ -74,5 +75,6 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativemanagement.Forwarder),
+		new(kafka_nativemanagement.Forwarder),
 	}
 }
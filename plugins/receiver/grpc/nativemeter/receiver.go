@@ -22,6 +22,9 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	forwarder_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemeter"
+	kafka_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemeter"
+	otlp_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativemeter"
+	prometheusremotewrite_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/nativemeter"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
@@ -74,5 +77,8 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(forwarder_nativemeter.Forwarder),
+		new(kafka_nativemeter.Forwarder),
+		new(prometheusremotewrite_nativemeter.Forwarder),
+		new(otlp_nativemeter.Forwarder),
 	}
 }
@@ -21,7 +21,10 @@ import (
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativetracing"
 	frowarder_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativetracing"
+	kafka_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativetracing"
+	otlp_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativetracing"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	v3 "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
@@ -77,5 +80,8 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(frowarder_nativetracing.Forwarder),
+		new(elasticsearch_nativetracing.Forwarder),
+		new(otlp_nativetracing.Forwarder),
+		new(kafka_nativetracing.Forwarder),
 	}
 }
@@ -0,0 +1,216 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlplogsv1
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+
+	v1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/logs/v1"
+
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	otlpcommon "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	sniffer "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	serviceNameKey     = "service.name"
+	serviceInstanceKey = "service.instance.id"
+	levelTagKey        = "level"
+	spanIDTagKey       = "span_id"
+)
+
+var severityLevels = []string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// NewNativeLogsEvent builds the native logging event with the converted logs, return nil when there is no log.
+func NewNativeLogsEvent(name string, req *logs.ExportLogsServiceRequest) *sniffer.SniffData {
+	nativeLogs := ConvertToNativeLogs(req)
+	dataList := make([][]byte, 0, len(nativeLogs))
+	for _, d := range nativeLogs {
+		data, err := proto.Marshal(d)
+		if err != nil {
+			log.Logger.Warnf("%s marshal the native log failure: %v", name, err)
+			continue
+		}
+		dataList = append(dataList, data)
+	}
+	if len(dataList) == 0 {
+		return nil
+	}
+	return &sniffer.SniffData{
+		Name:      name,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Meta:      nil,
+		Type:      sniffer.SniffType_Logging,
+		Remote:    true,
+		Data: &sniffer.SniffData_LogList{
+			LogList: &sniffer.BatchLogList{
+				Logs: dataList,
+			},
+		},
+	}
+}
+
+// ConvertToNativeLogs converts the OpenTelemetry log records to the SkyWalking native logs.
+// The service and service instance come from the "service.name" and "service.instance.id"
+// resource attributes, the other resource attributes and the record attributes are kept as tags.
+func ConvertToNativeLogs(req *logs.ExportLogsServiceRequest) []*logging.LogData {
+	result := make([]*logging.LogData, 0)
+	for _, resourceLogs := range req.GetResourceLogs() {
+		var service, instance string
+		resourceTags := make([]*common.KeyStringValuePair, 0)
+		for _, kv := range resourceLogs.GetResource().GetAttributes() {
+			switch kv.GetKey() {
+			case serviceNameKey:
+				service = anyValueToString(kv.GetValue())
+			case serviceInstanceKey:
+				instance = anyValueToString(kv.GetValue())
+			default:
+				resourceTags = append(resourceTags, &common.KeyStringValuePair{Key: kv.GetKey(), Value: anyValueToString(kv.GetValue())})
+			}
+		}
+		for _, scopeLogs := range resourceLogs.GetScopeLogs() {
+			for _, record := range scopeLogs.GetLogRecords() {
+				result = append(result, convertLogRecord(service, instance, resourceTags, record))
+			}
+		}
+	}
+	return result
+}
+
+func convertLogRecord(service, instance string, resourceTags []*common.KeyStringValuePair, record *v1.LogRecord) *logging.LogData {
+	tags := make([]*common.KeyStringValuePair, 0, len(resourceTags)+len(record.GetAttributes())+2)
+	tags = append(tags, resourceTags...)
+	if level := severityLevel(record); level != "" {
+		tags = append(tags, &common.KeyStringValuePair{Key: levelTagKey, Value: level})
+	}
+	for _, kv := range record.GetAttributes() {
+		tags = append(tags, &common.KeyStringValuePair{Key: kv.GetKey(), Value: anyValueToString(kv.GetValue())})
+	}
+
+	timestamp := record.GetTimeUnixNano()
+	if timestamp == 0 {
+		timestamp = record.GetObservedTimeUnixNano()
+	}
+	data := &logging.LogData{
+		Timestamp:       int64(timestamp / 1e6),
+		Service:         service,
+		ServiceInstance: instance,
+		Body:            convertBody(record.GetBody()),
+		Tags:            &logging.LogTags{Data: tags},
+	}
+	if len(record.GetTraceId()) > 0 {
+		data.TraceContext = &logging.TraceContext{TraceId: hex.EncodeToString(record.GetTraceId())}
+	}
+	// the OTLP span ID is not a SkyWalking segment ID, so it is kept as a tag.
+	if len(record.GetSpanId()) > 0 {
+		data.Tags.Data = append(data.Tags.Data, &common.KeyStringValuePair{Key: spanIDTagKey, Value: hex.EncodeToString(record.GetSpanId())})
+	}
+	return data
+}
+
+func severityLevel(record *v1.LogRecord) string {
+	if record.GetSeverityText() != "" {
+		return record.GetSeverityText()
+	}
+	number := int(record.GetSeverityNumber())
+	if number <= 0 {
+		return ""
+	}
+	index := (number - 1) / 4
+	if index >= len(severityLevels) {
+		index = len(severityLevels) - 1
+	}
+	return severityLevels[index]
+}
+
+func convertBody(body *otlpcommon.AnyValue) *logging.LogDataBody {
+	switch body.GetValue().(type) {
+	case *otlpcommon.AnyValue_KvlistValue, *otlpcommon.AnyValue_ArrayValue:
+		return &logging.LogDataBody{
+			Type:    "json",
+			Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: anyValueToString(body)}},
+		}
+	default:
+		return &logging.LogDataBody{
+			Type:    "text",
+			Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: anyValueToString(body)}},
+		}
+	}
+}
+
+func anyValueToString(value *otlpcommon.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *otlpcommon.AnyValue_StringValue:
+		return v.StringValue
+	case *otlpcommon.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *otlpcommon.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *otlpcommon.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'f', -1, 64)
+	case *otlpcommon.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *otlpcommon.AnyValue_ArrayValue, *otlpcommon.AnyValue_KvlistValue:
+		data, err := json.Marshal(anyValueToInterface(value))
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	default:
+		return ""
+	}
+}
+
+func anyValueToInterface(value *otlpcommon.AnyValue) interface{} {
+	switch v := value.GetValue().(type) {
+	case *otlpcommon.AnyValue_StringValue:
+		return v.StringValue
+	case *otlpcommon.AnyValue_BoolValue:
+		return v.BoolValue
+	case *otlpcommon.AnyValue_IntValue:
+		return v.IntValue
+	case *otlpcommon.AnyValue_DoubleValue:
+		return v.DoubleValue
+	case *otlpcommon.AnyValue_BytesValue:
+		return v.BytesValue
+	case *otlpcommon.AnyValue_ArrayValue:
+		values := make([]interface{}, 0, len(v.ArrayValue.GetValues()))
+		for _, item := range v.ArrayValue.GetValues() {
+			values = append(values, anyValueToInterface(item))
+		}
+		return values
+	case *otlpcommon.AnyValue_KvlistValue:
+		values := make(map[string]interface{}, len(v.KvlistValue.GetValues()))
+		for _, kv := range v.KvlistValue.GetValues() {
+			values[kv.GetKey()] = anyValueToInterface(kv.GetValue())
+		}
+		return values
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,93 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlplogsv1
+
+import (
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	grpc_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlplogsv1"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
+
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+	sniffer "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name     = "grpc-otlp-logs-v1-receiver"
+	ShowName = "GRPC OpenTelemetry Logs v1 Receiver"
+)
+
+type Receiver struct {
+	config.CommonFields
+	grpc.CommonGRPCReceiverFields
+	// Convert the OpenTelemetry log records to the SkyWalking native logs, which could be sent by the native log forwarders.
+	ConvertToNative bool `mapstructure:"convert_to_native"`
+	service         *LogsService
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for OpenTelemetry Logs v1 format, " +
+		"which is defined at https://github.com/open-telemetry/opentelemetry-proto/blob/" +
+		"v1.0.0/opentelemetry/proto/collector/logs/v1/logs_service.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# Convert the OpenTelemetry log records to the SkyWalking native logs, which could be sent by the native log forwarders.
+# The trace ID is kept in the trace context, and the span ID is kept as the "span_id" tag.
+convert_to_native: false
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.CommonGRPCReceiverFields = *grpc.InitCommonGRPCReceiverFields(server)
+	r.service = &LogsService{receiveChannel: r.OutputChannel, convertToNative: r.ConvertToNative}
+	logs.RegisterLogsServiceServer(r.Server, r.service)
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) Channel() <-chan *sniffer.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(otlplogsv1.Forwarder),
+		new(grpc_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
+		new(kafka_nativelog.Forwarder),
+	}
+}
@@ -0,0 +1,140 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlplogsv1
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+	v1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/logs/v1"
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	otlpcommon "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+	sniffer "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver_grpc "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
+)
+
+func TestReceiver_RegisterHandler(t *testing.T) {
+	recConf := make(map[string]string, 2)
+	receiver_grpc.TestReceiverWithConfig(new(Receiver), recConf, func(t *testing.T, sequence int, conn *grpc.ClientConn, ctx context.Context) string {
+		client := logs.NewLogsServiceClient(conn)
+		data := initData()
+		_, err := client.Export(ctx, data)
+		if err != nil {
+			t.Fatalf("cannot open the stream send mode: %v", err)
+		}
+		return data.String()
+	}, func(data *sniffer.SniffData) string {
+		if data.GetType() != event.OpenTelemetryLogsV1Type {
+			return ""
+		}
+		req := &logs.ExportLogsServiceRequest{}
+		if err := proto.Unmarshal(event.ExtensionData(data), req); err != nil {
+			return ""
+		}
+		return req.String()
+	}, t)
+}
+
+func TestReceiver_ConvertToNative(t *testing.T) {
+	recConf := make(map[string]string, 2)
+	recConf["convert_to_native"] = "true"
+	receiver_grpc.TestReceiverWithConfig(new(Receiver), recConf, func(t *testing.T, sequence int, conn *grpc.ClientConn, ctx context.Context) string {
+		client := logs.NewLogsServiceClient(conn)
+		_, err := client.Export(ctx, initData())
+		if err != nil {
+			t.Fatalf("cannot open the stream send mode: %v", err)
+		}
+		expected := &logging.LogData{
+			Timestamp:       1600000000000,
+			Service:         "test-service",
+			ServiceInstance: "test-instance",
+			Body: &logging.LogDataBody{
+				Type:    "text",
+				Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "test log"}},
+			},
+			TraceContext: &logging.TraceContext{
+				TraceId: "0102030405060708090a0b0c0d0e0f10",
+			},
+			Tags: &logging.LogTags{Data: []*common.KeyStringValuePair{
+				{Key: "host.name", Value: "test-host"},
+				{Key: "level", Value: "ERROR"},
+				{Key: "http.status_code", Value: "500"},
+				{Key: "span_id", Value: "0102030405060708"},
+			}},
+		}
+		return expected.String()
+	}, func(data *sniffer.SniffData) string {
+		if data.GetType() != sniffer.SniffType_Logging || len(data.GetLogList().GetLogs()) != 1 {
+			return ""
+		}
+		logData := &logging.LogData{}
+		if err := proto.Unmarshal(data.GetLogList().GetLogs()[0], logData); err != nil {
+			return ""
+		}
+		return logData.String()
+	}, t)
+}
+
+func initData() *logs.ExportLogsServiceRequest {
+	return &logs.ExportLogsServiceRequest{
+		ResourceLogs: []*v1.ResourceLogs{
+			{
+				Resource: &resource.Resource{
+					Attributes: []*otlpcommon.KeyValue{
+						stringKeyValue("service.name", "test-service"),
+						stringKeyValue("service.instance.id", "test-instance"),
+						stringKeyValue("host.name", "test-host"),
+					},
+				},
+				ScopeLogs: []*v1.ScopeLogs{
+					{
+						LogRecords: []*v1.LogRecord{
+							{
+								TimeUnixNano:   1600000000000000000,
+								SeverityNumber: v1.SeverityNumber_SEVERITY_NUMBER_ERROR,
+								Body:           &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: "test log"}},
+								Attributes: []*otlpcommon.KeyValue{
+									{Key: "http.status_code", Value: &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_IntValue{IntValue: 500}}},
+								},
+								TraceId: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+								SpanId:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringKeyValue(key, value string) *otlpcommon.KeyValue {
+	return &otlpcommon.KeyValue{
+		Key:   key,
+		Value: &otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: value}},
+	}
+}
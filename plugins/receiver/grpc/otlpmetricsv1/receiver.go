@@ -22,6 +22,7 @@ import (
 	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
 	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlpmetricsv1"
+	prometheusremotewrite_otlpmetricsv1 "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/otlpmetricsv1"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc"
 
 	metrics "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/collector/metrics/v1"
@@ -73,5 +74,6 @@ func (r *Receiver) Channel() <-chan *sniffer.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(otlpmetricsv1.Forwarder),
+		new(prometheusremotewrite_otlpmetricsv1.Forwarder),
 	}
 }
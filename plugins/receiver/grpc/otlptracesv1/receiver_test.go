@@ -0,0 +1,94 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlptracesv1
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	trace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	v1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/trace/v1"
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+	sniffer "skywalking.apache.org/repo/goapi/satellite/data/v1"
+
+	"google.golang.org/grpc"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver_grpc "github.com/apache/skywalking-satellite/plugins/receiver/grpc"
+)
+
+func TestReceiver_RegisterHandler(t *testing.T) {
+	recConf := make(map[string]string, 2)
+	receiver_grpc.TestReceiverWithConfig(new(Receiver), recConf, func(t *testing.T, sequence int, conn *grpc.ClientConn, ctx context.Context) string {
+		client := trace.NewTraceServiceClient(conn)
+		data := initData()
+		_, err := client.Export(ctx, data)
+		if err != nil {
+			t.Fatalf("cannot open the stream send mode: %v", err)
+		}
+		return data.String()
+	}, func(data *sniffer.SniffData) string {
+		if data.GetType() != event.OpenTelemetryTracesV1Type {
+			return ""
+		}
+		req := &trace.ExportTraceServiceRequest{}
+		if err := proto.Unmarshal(event.ExtensionData(data), req); err != nil {
+			return ""
+		}
+		return req.String()
+	}, t)
+}
+
+func initData() *trace.ExportTraceServiceRequest {
+	return &trace.ExportTraceServiceRequest{
+		ResourceSpans: []*v1.ResourceSpans{
+			{
+				Resource: &resource.Resource{
+					Attributes: []*common.KeyValue{
+						{
+							Key: "service.name",
+							Value: &common.AnyValue{
+								Value: &common.AnyValue_StringValue{
+									StringValue: "test-service",
+								},
+							},
+						},
+					},
+				},
+				ScopeSpans: []*v1.ScopeSpans{
+					{
+						Spans: []*v1.Span{
+							{
+								TraceId:           []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+								SpanId:            []byte{1, 2, 3, 4, 5, 6, 7, 8},
+								Name:              "/test",
+								Kind:              v1.Span_SPAN_KIND_SERVER,
+								StartTimeUnixNano: 1600000000000000000,
+								EndTimeUnixNano:   1600000000100000000,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlptracesv1
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+
+	trace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	sniffer "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const eventName = "grpc-otlp-traces-v1-event"
+
+type TraceService struct {
+	receiveChannel chan *sniffer.SniffData
+	trace.UnimplementedTraceServiceServer
+}
+
+func (t *TraceService) Export(_ context.Context, req *trace.ExportTraceServiceRequest) (*trace.ExportTraceServiceResponse, error) {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	t.receiveChannel <- event.NewExtensionEvent(eventName, event.OpenTelemetryTracesV1Type, data)
+	return &trace.ExportTraceServiceResponse{}, nil
+}
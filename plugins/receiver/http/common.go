@@ -0,0 +1,104 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/golang/snappy"
+)
+
+// ReadBody reads the whole request body, the body would be decompressed when the content encoding is gzip or snappy.
+// The error is returned when the body exceeds the max size, the compressed body is checked by the decompressed size.
+func ReadBody(req *http.Request, maxSize int64) ([]byte, error) {
+	body := io.LimitReader(req.Body, maxSize+1)
+	switch encoding := strings.ToLower(req.Header.Get("Content-Encoding")); encoding {
+	case "", "identity":
+		return readLimited(body, maxSize)
+	case "gzip":
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the gzip body: %v", err)
+		}
+		defer gzipReader.Close()
+		return readLimited(gzipReader, maxSize)
+	case "snappy":
+		data, err := readLimited(body, maxSize)
+		if err != nil {
+			return nil, err
+		}
+		size, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the snappy body: %v", err)
+		}
+		if int64(size) > maxSize {
+			return nil, fmt.Errorf("the decompressed body size %d exceeds the max size %d", size, maxSize)
+		}
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", encoding)
+	}
+}
+
+func readLimited(reader io.Reader, maxSize int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("the body exceeds the max size %d", maxSize)
+	}
+	return data, nil
+}
+
+// IsProtobufContent returns true when the request body is encoded by the protobuf.
+func IsProtobufContent(req *http.Request) bool {
+	contentType := strings.ToLower(req.Header.Get("Content-Type"))
+	return strings.HasPrefix(contentType, "application/x-protobuf") || strings.HasPrefix(contentType, "application/protobuf")
+}
+
+// CORSHandler wraps the handler with the CORS headers, the preflight requests are answered directly.
+// The allowed origins could be "*" to allow all origins.
+func CORSHandler(allowedOrigins []string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if origin := req.Header.Get("Origin"); origin != "" && isAllowedOrigin(allowedOrigins, origin) {
+			rsp.Header().Set("Access-Control-Allow-Origin", origin)
+			rsp.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+			rsp.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Encoding")
+			rsp.Header().Add("Vary", "Origin")
+		}
+		if req.Method == http.MethodOptions {
+			rsp.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.ServeHTTP(rsp, req)
+	})
+}
+
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/snappy"
+)
+
+func TestReadBody(t *testing.T) {
+	small := []byte("small body")
+	large := bytes.Repeat([]byte("a"), 1024)
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+		exceeded bool
+	}{
+		{name: "identity", body: small},
+		{name: "identity exceeded", body: large, exceeded: true},
+		{name: "gzip", encoding: "gzip", body: small},
+		{name: "gzip exceeded", encoding: "gzip", body: large, exceeded: true},
+		{name: "snappy", encoding: "snappy", body: small},
+		{name: "snappy exceeded", encoding: "snappy", body: large, exceeded: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var content []byte
+			switch tt.encoding {
+			case "gzip":
+				var buf bytes.Buffer
+				writer := gzip.NewWriter(&buf)
+				_, _ = writer.Write(tt.body)
+				_ = writer.Close()
+				content = buf.Bytes()
+			case "snappy":
+				content = snappy.Encode(nil, tt.body)
+			default:
+				content = tt.body
+			}
+			// the compressed large body is smaller than the max size, so it is limited by the decompressed size.
+			if tt.encoding != "" && len(content) >= 512 {
+				t.Fatalf("the compressed body is too large: %d", len(content))
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(content))
+			req.Header.Set("Content-Encoding", tt.encoding)
+			data, err := ReadBody(req, 512)
+			if tt.exceeded {
+				if err == nil {
+					t.Fatalf("expected the error when the body exceeds the max size")
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(data, tt.body) {
+				t.Errorf("expected the body %q, but got %q, error: %v", tt.body, data, err)
+			}
+		})
+	}
+}
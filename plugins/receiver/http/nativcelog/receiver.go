@@ -30,8 +30,12 @@ import (
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
 	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
 	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
 	frowarder_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
 	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
 
 	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
@@ -117,7 +121,7 @@ func (r *Receiver) httpHandler() http.Handler {
 		e := &v1.SniffData{
 			Name:      eventName,
 			Timestamp: time.Now().UnixNano() / 1e6,
-			Meta:      nil,
+			Meta:      event.SourcePeerMeta(req.RemoteAddr),
 			Type:      v1.SniffType_Logging,
 			Remote:    true,
 			Data: &v1.SniffData_LogList{
@@ -140,5 +144,8 @@ func (r *Receiver) Channel() <-chan *v1.SniffData {
 func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
 	return []forwarder.Forwarder{
 		new(frowarder_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
 	}
 }
@@ -0,0 +1,184 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativebrowser
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_browsererrorlog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativebrowsererrorlog"
+	forwarder_browserperf "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativebrowserperf"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name              = "http-native-browser-receiver"
+	ShowName          = "HTTP Native Browser Receiver"
+	perfDataEventName = "http-browser-perf-data-event"
+	errorLogEventName = "http-browser-error-log-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	PerfDataURI    string `mapstructure:"perf_data_uri"`   // The browser performance data request URI.
+	ErrorLogURI    string `mapstructure:"error_log_uri"`   // The single browser error log request URI.
+	ErrorLogsURI   string `mapstructure:"error_logs_uri"`  // The browser error logs request URI.
+	Timeout        int    `mapstructure:"timeout"`         // The request timeout seconds.
+	AllowedOrigins string `mapstructure:"allowed_origins"` // The allowed origins of the CORS requests, multiple origins are split by ",", "*" means all origins.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the SkyWalking browser performance data and error logs over HTTP, which is reported by skywalking-client-js, " +
+		"the JSON format follows the protocol defined at https://github.com/apache/skywalking-data-collect-protocol/blob/master/browser/BrowserPerf.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The browser performance data request URI.
+perf_data_uri: "/browser/perfData"
+# The single browser error log request URI.
+error_log_uri: "/browser/errorLog"
+# The browser error logs request URI.
+error_logs_uri: "/browser/errorLogs"
+# The request timeout seconds.
+timeout: 5
+# The allowed origins of the CORS requests, multiple origins are split by ",", "*" means all origins.
+allowed_origins: "*"
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	origins := strings.Split(r.AllowedOrigins, ",")
+	for i := range origins {
+		origins[i] = strings.TrimSpace(origins[i])
+	}
+	r.Server.Server.Handle(r.PerfDataURI, http_receiver.CORSHandler(origins, r.httpHandler(r.decodePerfData)))
+	r.Server.Server.Handle(r.ErrorLogURI, http_receiver.CORSHandler(origins, r.httpHandler(r.decodeErrorLog)))
+	r.Server.Server.Handle(r.ErrorLogsURI, http_receiver.CORSHandler(origins, r.httpHandler(r.decodeErrorLogs)))
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler(decoder func(body []byte) ([]*v1.SniffData, error)) http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("get http body error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		events, err := decoder(body)
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, e := range events {
+			r.OutputChannel <- e
+		}
+		rsp.WriteHeader(http.StatusOK)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func (r *Receiver) decodePerfData(body []byte) ([]*v1.SniffData, error) {
+	e, err := decodeEvent(body, &agent.BrowserPerfData{}, perfDataEventName, event.BrowserPerfDataType)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the browser performance data: %v", err)
+	}
+	return []*v1.SniffData{e}, nil
+}
+
+func (r *Receiver) decodeErrorLog(body []byte) ([]*v1.SniffData, error) {
+	e, err := decodeEvent(body, &agent.BrowserErrorLog{}, errorLogEventName, event.BrowserErrorLogType)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the browser error log: %v", err)
+	}
+	return []*v1.SniffData{e}, nil
+}
+
+func (r *Receiver) decodeErrorLogs(body []byte) ([]*v1.SniffData, error) {
+	var logs []json.RawMessage
+	if err := json.Unmarshal(body, &logs); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the browser error logs: %v", err)
+	}
+	events := make([]*v1.SniffData, 0, len(logs))
+	for _, l := range logs {
+		e, err := decodeEvent(l, &agent.BrowserErrorLog{}, errorLogEventName, event.BrowserErrorLogType)
+		if err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the browser error logs: %v", err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func decodeEvent(body []byte, msg proto.Message, name string, t v1.SniffType) (*v1.SniffData, error) {
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg); err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewExtensionEvent(name, t, data), nil
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_browserperf.Forwarder),
+		new(forwarder_browsererrorlog.Forwarder),
+	}
+}
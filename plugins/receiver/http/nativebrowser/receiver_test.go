@@ -0,0 +1,148 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativebrowser
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+const testAddress = "localhost:12814"
+
+func TestReceiver_PerfData(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/browser/perfData", `{"service": "demo-web", "serviceVersion": "v1.0.0", "pagePath": "/index.html", `+
+		`"dnsTime": 10, "loadPageTime": 300, "unknownField": 1}`, http.StatusOK)
+	e := <-r.Channel()
+	if e.GetType() != event.BrowserPerfDataType {
+		t.Fatalf("the event type should be %d, but got %d", event.BrowserPerfDataType, e.GetType())
+	}
+	received := &agent.BrowserPerfData{}
+	if err := proto.Unmarshal(event.ExtensionData(e), received); err != nil {
+		t.Fatalf("cannot unmarshal the performance data: %v", err)
+	}
+	want := &agent.BrowserPerfData{Service: "demo-web", ServiceVersion: "v1.0.0", PagePath: "/index.html", DnsTime: 10, LoadPageTime: 300}
+	if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received performance data is not equal to the sent data: %s", diff)
+	}
+}
+
+func TestReceiver_ErrorLogs(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/browser/errorLogs", `[{"uniqueId": "1", "service": "demo-web", "category": "js", "message": "error 1"},`+
+		`{"uniqueId": "2", "service": "demo-web", "category": "ajax", "message": "error 2"}]`, http.StatusOK)
+	for _, want := range []*agent.BrowserErrorLog{
+		{UniqueId: "1", Service: "demo-web", Category: agent.ErrorCategory_js, Message: "error 1"},
+		{UniqueId: "2", Service: "demo-web", Category: agent.ErrorCategory_ajax, Message: "error 2"},
+	} {
+		e := <-r.Channel()
+		if e.GetType() != event.BrowserErrorLogType {
+			t.Fatalf("the event type should be %d, but got %d", event.BrowserErrorLogType, e.GetType())
+		}
+		received := &agent.BrowserErrorLog{}
+		if err := proto.Unmarshal(event.ExtensionData(e), received); err != nil {
+			t.Fatalf("cannot unmarshal the error log: %v", err)
+		}
+		if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+			t.Fatalf("the received error log is not equal to the sent log: %s", diff)
+		}
+	}
+}
+
+func TestReceiver_IllegalErrorLog(t *testing.T) {
+	startReceiver(t)
+	post(t, "/browser/errorLog", `{"uniqueId": 1`, http.StatusBadRequest)
+}
+
+func TestReceiver_CORS(t *testing.T) {
+	startReceiver(t)
+	req, err := http.NewRequest(http.MethodOptions, fmt.Sprintf("http://%s/browser/perfData", testAddress), http.NoBody)
+	if err != nil {
+		t.Fatalf("cannot build the request: %v", err)
+	}
+	req.Header.Set("Origin", "http://localhost:8080")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("cannot request the http-server, error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("the response code should be %d, but got %d", http.StatusNoContent, resp.StatusCode)
+	}
+	if origin := resp.Header.Get("Access-Control-Allow-Origin"); origin != "http://localhost:8080" {
+		t.Fatalf("the allowed origin should be the request origin, but got %s", origin)
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, uri, data string, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s%s", testAddress, uri), "application/json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,160 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativeevent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_nativeevent "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativeevent"
+	kafka_nativeevent "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativeevent"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	nativeevent "skywalking.apache.org/repo/goapi/collect/event/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-native-event-receiver"
+	ShowName  = "HTTP Native Event Receiver"
+	eventName = "http-nativeevent-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	URI     string `mapstructure:"uri"`     // The events request URI.
+	Timeout int    `mapstructure:"timeout"` // The request timeout seconds.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver compatible with the SkyWalking OAP event REST API(/v3/events), which accepts the JSON array of the events " +
+		"or a single JSON event, the event format is defined at https://github.com/apache/skywalking-data-collect-protocol/blob/master/event/Event.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The events request URI.
+uri: "/v3/events"
+# The request timeout seconds.
+timeout: 5
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.URI, r.httpHandler())
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler() http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("get http body error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		events, err := decodeEvents(body)
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, item := range events {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      nil,
+				Type:      v1.SniffType_EventType,
+				Remote:    true,
+				Data: &v1.SniffData_Event{
+					Event: item,
+				},
+			}
+		}
+		data, err := protojson.Marshal(&common.Commands{})
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		rsp.Header().Set("Content-Type", "application/json")
+		rsp.WriteHeader(http.StatusOK)
+		_, _ = rsp.Write(data)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func decodeEvents(body []byte) ([]*nativeevent.Event, error) {
+	var items []json.RawMessage
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the json events: %v", err)
+		}
+	} else {
+		items = []json.RawMessage{trimmed}
+	}
+	events := make([]*nativeevent.Event, 0, len(items))
+	for _, item := range items {
+		e := &nativeevent.Event{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(item, e); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the json event: %v", err)
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativeevent.Forwarder),
+		new(kafka_nativeevent.Forwarder),
+	}
+}
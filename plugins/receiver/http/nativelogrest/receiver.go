@@ -0,0 +1,178 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelogrest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	grpc_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	"github.com/apache/skywalking-satellite/plugins/receiver/http/nativcelog"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-native-log-rest-receiver"
+	ShowName  = "HTTP Native Log REST Receiver"
+	eventName = "http-nativelog-rest-event"
+	success   = "success"
+	failing   = "failing"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	URI     string `mapstructure:"uri"`     // The native log request URI.
+	Timeout int    `mapstructure:"timeout"` // The request timeout seconds.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver compatible with the SkyWalking OAP logging REST API(/v3/logs), which accepts the JSON array of the logs, " +
+		"a single JSON log or a protobuf(application/x-protobuf) encoded log, the gzip content encoding is supported. " +
+		"The log format is defined at https://github.com/apache/skywalking-data-collect-protocol/blob/master/logging/Logging.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The native log request URI.
+uri: "/v3/logs"
+# The request timeout seconds.
+timeout: 5
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.URI, r.httpHandler())
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler() http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		rsp.Header().Set("Content-Type", "application/json")
+		if req.Method != http.MethodPost {
+			nativcelog.ResponseWithJSON(rsp, &nativcelog.Response{Status: failing, Msg: "only the POST method is supported"}, http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("get http body error: %v", err)
+			nativcelog.ResponseWithJSON(rsp, &nativcelog.Response{Status: failing, Msg: err.Error()}, http.StatusBadRequest)
+			return
+		}
+		logs, err := decodeLogs(req, body)
+		if err != nil {
+			nativcelog.ResponseWithJSON(rsp, &nativcelog.Response{Status: failing, Msg: err.Error()}, http.StatusBadRequest)
+			return
+		}
+		if len(logs) > 0 {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      event.SourcePeerMeta(req.RemoteAddr),
+				Type:      v1.SniffType_Logging,
+				Remote:    true,
+				Data: &v1.SniffData_LogList{
+					LogList: &v1.BatchLogList{
+						Logs: logs,
+					},
+				},
+			}
+		}
+		nativcelog.ResponseWithJSON(rsp, &nativcelog.Response{Status: success, Msg: success}, http.StatusOK)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+// decodeLogs decodes the request body to the serialized logs.
+func decodeLogs(req *http.Request, body []byte) ([][]byte, error) {
+	if http_receiver.IsProtobufContent(req) {
+		if err := proto.Unmarshal(body, &logging.LogData{}); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the protobuf log: %v", err)
+		}
+		return [][]byte{body}, nil
+	}
+	var items []json.RawMessage
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &items); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the json logs: %v", err)
+		}
+	} else {
+		items = []json.RawMessage{trimmed}
+	}
+	logs := make([][]byte, 0, len(items))
+	for _, item := range items {
+		data := &logging.LogData{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(item, data); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal the json log: %v", err)
+		}
+		content, err := proto.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, content)
+	}
+	return logs, nil
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(grpc_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
+		new(kafka_nativelog.Forwarder),
+	}
+}
@@ -0,0 +1,164 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativelogrest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress = "localhost:12815"
+	jsonLogs    = `[{
+  "timestamp": 1600000000000,
+  "service": "demo-job",
+  "serviceInstance": "host-1",
+  "body": {"text": {"text": "job started"}},
+  "tags": {"data": [{"key": "level", "value": "INFO"}]}
+}, {
+  "service": "demo-job",
+  "body": {"json": {"json": "{\"status\": \"done\"}"}},
+  "traceContext": {"traceId": "trace-1", "traceSegmentId": "segment-1", "spanId": 1}
+}]`
+)
+
+func TestReceiver_GzipJSONLogs(t *testing.T) {
+	r := startReceiver(t)
+	body := bytes.NewBuffer(nil)
+	writer := gzip.NewWriter(body)
+	_, _ = writer.Write([]byte(jsonLogs))
+	_ = writer.Close()
+	go post(t, "application/json", "gzip", body.Bytes(), http.StatusOK)
+	e := <-r.Channel()
+	want := []*logging.LogData{
+		{
+			Timestamp:       1600000000000,
+			Service:         "demo-job",
+			ServiceInstance: "host-1",
+			Body:            &logging.LogDataBody{Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "job started"}}},
+			Tags:            &logging.LogTags{Data: []*common.KeyStringValuePair{{Key: "level", Value: "INFO"}}},
+		},
+		{
+			Service:      "demo-job",
+			Body:         &logging.LogDataBody{Content: &logging.LogDataBody_Json{Json: &logging.JSONLog{Json: `{"status": "done"}`}}},
+			TraceContext: &logging.TraceContext{TraceId: "trace-1", TraceSegmentId: "segment-1", SpanId: 1},
+		},
+	}
+	checkLogs(t, e, want)
+}
+
+func TestReceiver_ProtobufLog(t *testing.T) {
+	r := startReceiver(t)
+	sent := &logging.LogData{Service: "demo-job", Body: &logging.LogDataBody{Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "log"}}}}
+	data, err := proto.Marshal(sent)
+	if err != nil {
+		t.Fatalf("cannot marshal the log: %v", err)
+	}
+	go post(t, "application/x-protobuf", "", data, http.StatusOK)
+	checkLogs(t, <-r.Channel(), []*logging.LogData{sent})
+}
+
+func TestReceiver_IllegalLogs(t *testing.T) {
+	startReceiver(t)
+	post(t, "application/json", "", []byte(`[{"service": 1}]`), http.StatusBadRequest)
+}
+
+func checkLogs(t *testing.T, e *v1.SniffData, want []*logging.LogData) {
+	if e.GetType() != v1.SniffType_Logging {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_Logging, e.GetType())
+	}
+	if len(e.GetLogList().GetLogs()) != len(want) {
+		t.Fatalf("the log count should be %d, but got %d", len(want), len(e.GetLogList().GetLogs()))
+	}
+	for i, content := range e.GetLogList().GetLogs() {
+		received := &logging.LogData{}
+		if err := proto.Unmarshal(content, received); err != nil {
+			t.Fatalf("cannot unmarshal the log: %v", err)
+		}
+		if diff := cmp.Diff(want[i], received, protocmp.Transform()); diff != "" {
+			t.Fatalf("the received log is not equal to the sent log: %s", diff)
+		}
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, contentType, contentEncoding string, data []byte, wantCode int) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/v3/logs", testAddress), bytes.NewBuffer(data))
+	if err != nil {
+		t.Errorf("cannot build the request, error: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", contentType)
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
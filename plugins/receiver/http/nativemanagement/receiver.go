@@ -0,0 +1,171 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativemanagement
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_nativemanagement "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemanagement"
+	kafka_nativemanagement "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemanagement"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	management "skywalking.apache.org/repo/goapi/collect/management/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-native-management-receiver"
+	ShowName  = "HTTP Native Management Receiver"
+	eventName = "http-management-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	PropertiesURI string `mapstructure:"properties_uri"` // The instance properties request URI.
+	KeepAliveURI  string `mapstructure:"keep_alive_uri"` // The instance keep alive request URI.
+	Timeout       int    `mapstructure:"timeout"`        // The request timeout seconds.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver compatible with the SkyWalking OAP management REST API" +
+		"(/v3/management/reportProperties and /v3/management/keepAlive), the JSON format is defined at " +
+		"https://github.com/apache/skywalking-data-collect-protocol/blob/master/management/Management.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The instance properties request URI.
+properties_uri: "/v3/management/reportProperties"
+# The instance keep alive request URI.
+keep_alive_uri: "/v3/management/keepAlive"
+# The request timeout seconds.
+timeout: 5
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.PropertiesURI, r.httpHandler(decodeProperties))
+	r.Server.Server.Handle(r.KeepAliveURI, r.httpHandler(decodeKeepAlive))
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler(decoder func(body []byte) (*v1.SniffData, error)) http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("get http body error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		e, err := decoder(body)
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.OutputChannel <- e
+		writeCommands(rsp)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func decodeProperties(body []byte) (*v1.SniffData, error) {
+	properties := &management.InstanceProperties{}
+	if err := unmarshalJSON(body, properties); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the instance properties: %v", err)
+	}
+	e := newEvent()
+	e.Data = &v1.SniffData_Instance{Instance: properties}
+	return e, nil
+}
+
+func decodeKeepAlive(body []byte) (*v1.SniffData, error) {
+	ping := &management.InstancePingPkg{}
+	if err := unmarshalJSON(body, ping); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the instance ping: %v", err)
+	}
+	e := newEvent()
+	e.Data = &v1.SniffData_InstancePing{InstancePing: ping}
+	return e, nil
+}
+
+func unmarshalJSON(body []byte, msg proto.Message) error {
+	return (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg)
+}
+
+func newEvent() *v1.SniffData {
+	return &v1.SniffData{
+		Name:      eventName,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Meta:      nil,
+		Type:      v1.SniffType_ManagementType,
+		Remote:    true,
+	}
+}
+
+func writeCommands(rsp http.ResponseWriter) {
+	data, err := protojson.Marshal(&common.Commands{})
+	if err != nil {
+		http.Error(rsp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rsp.Header().Set("Content-Type", "application/json")
+	rsp.WriteHeader(http.StatusOK)
+	_, _ = rsp.Write(data)
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativemanagement.Forwarder),
+		new(kafka_nativemanagement.Forwarder),
+	}
+}
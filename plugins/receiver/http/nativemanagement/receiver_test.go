@@ -0,0 +1,114 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativemanagement
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	management "skywalking.apache.org/repo/goapi/collect/management/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const testAddress = "localhost:12817"
+
+func TestReceiver_ReportProperties(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/v3/management/reportProperties", `{"service": "nginx", "serviceInstance": "nginx-1", `+
+		`"properties": [{"key": "os_name", "value": "linux"}]}`, http.StatusOK)
+	e := <-r.Channel()
+	if e.GetType() != v1.SniffType_ManagementType {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_ManagementType, e.GetType())
+	}
+	want := &management.InstanceProperties{
+		Service:         "nginx",
+		ServiceInstance: "nginx-1",
+		Properties:      []*common.KeyStringValuePair{{Key: "os_name", Value: "linux"}},
+	}
+	if diff := cmp.Diff(want, e.GetInstance(), protocmp.Transform()); diff != "" {
+		t.Fatalf("the received properties are not equal to the sent properties: %s", diff)
+	}
+}
+
+func TestReceiver_KeepAlive(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/v3/management/keepAlive", `{"service": "nginx", "serviceInstance": "nginx-1"}`, http.StatusOK)
+	e := <-r.Channel()
+	want := &management.InstancePingPkg{Service: "nginx", ServiceInstance: "nginx-1"}
+	if diff := cmp.Diff(want, e.GetInstancePing(), protocmp.Transform()); diff != "" {
+		t.Fatalf("the received ping is not equal to the sent ping: %s", diff)
+	}
+}
+
+func TestReceiver_IllegalKeepAlive(t *testing.T) {
+	startReceiver(t)
+	post(t, "/v3/management/keepAlive", `{"service": 1}`, http.StatusBadRequest)
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, uri, data string, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s%s", testAddress, uri), "application/json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,174 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativetracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativetracing"
+	forwarder_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativetracing"
+	kafka_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativetracing"
+	otlp_nativetracing "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativetracing"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-native-tracing-receiver"
+	ShowName  = "HTTP Native Tracing Receiver"
+	eventName = "http-tracing-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	SegmentURI  string `mapstructure:"segment_uri"`  // The single segment request URI.
+	SegmentsURI string `mapstructure:"segments_uri"` // The segment list request URI.
+	Timeout     int    `mapstructure:"timeout"`      // The request timeout seconds.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver compatible with the SkyWalking OAP tracing REST API(/v3/segment and /v3/segments), " +
+		"which is used by the HTTP agents such as the SkyWalking Nginx Lua agent. The JSON segment format is defined at " +
+		"https://github.com/apache/skywalking-data-collect-protocol/blob/master/language-agent/Tracing.proto."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The single segment request URI.
+segment_uri: "/v3/segment"
+# The segment list request URI.
+segments_uri: "/v3/segments"
+# The request timeout seconds.
+timeout: 5
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.SegmentURI, r.httpHandler(decodeSegment))
+	r.Server.Server.Handle(r.SegmentsURI, r.httpHandler(decodeSegments))
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler(decoder func(body []byte) ([][]byte, error)) http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("get http body error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		segments, err := decoder(body)
+		if err != nil {
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, segment := range segments {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      nil,
+				Type:      v1.SniffType_TracingType,
+				Remote:    true,
+				Data: &v1.SniffData_Segment{
+					Segment: segment,
+				},
+			}
+		}
+		rsp.WriteHeader(http.StatusOK)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func decodeSegment(body []byte) ([][]byte, error) {
+	segment, err := unmarshalSegment(body)
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{segment}, nil
+}
+
+func decodeSegments(body []byte) ([][]byte, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the json segments: %v", err)
+	}
+	segments := make([][]byte, 0, len(items))
+	for _, item := range items {
+		segment, err := unmarshalSegment(item)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+// unmarshalSegment converts the JSON segment to the serialized SegmentObject.
+func unmarshalSegment(data []byte) ([]byte, error) {
+	segment := &agent.SegmentObject{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, segment); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the json segment: %v", err)
+	}
+	return proto.Marshal(segment)
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativetracing.Forwarder),
+		new(elasticsearch_nativetracing.Forwarder),
+		new(otlp_nativetracing.Forwarder),
+		new(kafka_nativetracing.Forwarder),
+	}
+}
@@ -0,0 +1,154 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nativetracing
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	agent "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress = "localhost:12816"
+	jsonSegment = `{
+  "traceId": "trace-1",
+  "traceSegmentId": "segment-1",
+  "service": "nginx",
+  "serviceInstance": "nginx-1",
+  "spans": [{
+    "spanId": 0,
+    "parentSpanId": -1,
+    "startTime": 1600000000000,
+    "endTime": 1600000000100,
+    "operationName": "/api",
+    "peer": "backend:8080",
+    "spanType": "Entry",
+    "spanLayer": "Http",
+    "componentId": 6000,
+    "tags": [{"key": "http.method", "value": "GET"}]
+  }]
+}`
+)
+
+func TestReceiver_Segment(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/v3/segment", jsonSegment, http.StatusOK)
+	checkSegment(t, <-r.Channel(), "segment-1")
+}
+
+func TestReceiver_Segments(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "/v3/segments", fmt.Sprintf("[%s, %s]", jsonSegment,
+		`{"traceId": "trace-1", "traceSegmentId": "segment-2", "service": "nginx", "serviceInstance": "nginx-1"}`), http.StatusOK)
+	checkSegment(t, <-r.Channel(), "segment-1")
+	checkSegment(t, <-r.Channel(), "segment-2")
+}
+
+func TestReceiver_IllegalSegments(t *testing.T) {
+	startReceiver(t)
+	post(t, "/v3/segments", jsonSegment, http.StatusBadRequest)
+}
+
+func checkSegment(t *testing.T, e *v1.SniffData, segmentID string) {
+	if e.GetType() != v1.SniffType_TracingType {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_TracingType, e.GetType())
+	}
+	received := &agent.SegmentObject{}
+	if err := proto.Unmarshal(e.GetSegment(), received); err != nil {
+		t.Fatalf("cannot unmarshal the segment: %v", err)
+	}
+	want := &agent.SegmentObject{
+		TraceId:         "trace-1",
+		TraceSegmentId:  segmentID,
+		Service:         "nginx",
+		ServiceInstance: "nginx-1",
+	}
+	if segmentID == "segment-1" {
+		want.Spans = []*agent.SpanObject{{
+			SpanId:        0,
+			ParentSpanId:  -1,
+			StartTime:     1600000000000,
+			EndTime:       1600000000100,
+			OperationName: "/api",
+			Peer:          "backend:8080",
+			SpanType:      agent.SpanType_Entry,
+			SpanLayer:     agent.SpanLayer_Http,
+			ComponentId:   6000,
+			Tags:          []*common.KeyStringValuePair{{Key: "http.method", Value: "GET"}},
+		}}
+	}
+	if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received segment is not equal to the sent segment: %s", diff)
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, uri, data string, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s%s", testAddress, uri), "application/json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
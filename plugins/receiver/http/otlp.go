@@ -0,0 +1,123 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+// The identifiers are encoded as the hex string in the OTLP JSON encoding rather than the base64 string.
+var otlpHexIDFields = map[string]bool{
+	"traceId":      true,
+	"spanId":       true,
+	"parentSpanId": true,
+}
+
+// DecodeOTLPRequest decodes the OTLP/HTTP request body into the message,
+// both the binary protobuf and the JSON encoding are supported.
+func DecodeOTLPRequest(req *http.Request, msg proto.Message, maxSize int64) error {
+	body, err := ReadBody(req, maxSize)
+	if err != nil {
+		return err
+	}
+	if IsProtobufContent(req) {
+		if err := proto.Unmarshal(body, msg); err != nil {
+			return fmt.Errorf("cannot unmarshal the protobuf request: %v", err)
+		}
+		return nil
+	}
+	body, err = convertOTLPHexIDs(body)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal the json request: %v", err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg); err != nil {
+		return fmt.Errorf("cannot unmarshal the json request: %v", err)
+	}
+	return nil
+}
+
+// WriteOTLPResponse writes the response message with the same encoding of the request.
+func WriteOTLPResponse(rsp http.ResponseWriter, req *http.Request, msg proto.Message) {
+	var data []byte
+	var err error
+	if IsProtobufContent(req) {
+		rsp.Header().Set("Content-Type", "application/x-protobuf")
+		data, err = proto.Marshal(msg)
+	} else {
+		rsp.Header().Set("Content-Type", "application/json")
+		data, err = protojson.Marshal(msg)
+	}
+	if err != nil {
+		http.Error(rsp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rsp.WriteHeader(http.StatusOK)
+	if _, err := rsp.Write(data); err != nil {
+		log.Logger.Warnf("write the otlp response error: %v", err)
+	}
+}
+
+func convertOTLPHexIDs(body []byte) ([]byte, error) {
+	// keep the numbers as the literals, such as the nanosecond timestamps which overflow the float64 precision.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var content interface{}
+	if err := decoder.Decode(&content); err != nil {
+		return nil, err
+	}
+	if err := walkOTLPHexIDs(content); err != nil {
+		return nil, err
+	}
+	return json.Marshal(content)
+}
+
+func walkOTLPHexIDs(content interface{}) error {
+	switch v := content.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if id, ok := value.(string); ok && otlpHexIDFields[key] {
+				decoded, err := hex.DecodeString(id)
+				if err != nil {
+					return fmt.Errorf("the %s is not a hex string: %s", key, id)
+				}
+				v[key] = base64.StdEncoding.EncodeToString(decoded)
+				continue
+			}
+			if err := walkOTLPHexIDs(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := walkOTLPHexIDs(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
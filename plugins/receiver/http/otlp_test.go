@@ -0,0 +1,58 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+)
+
+func TestDecodeOTLPJSONRequest(t *testing.T) {
+	body := `{"resourceLogs": [{"scopeLogs": [{"logRecords": [{
+		"timeUnixNano": 1700000000123456789,
+		"observedTimeUnixNano": "1700000000987654321",
+		"traceId": "5b8efff798038103d269b633813fc60c",
+		"spanId": "eee19b7ec3c1b174",
+		"body": {"stringValue": "hello"}
+	}]}]}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/logs", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	msg := &logs.ExportLogsServiceRequest{}
+	if err := DecodeOTLPRequest(req, msg, 1024*1024); err != nil {
+		t.Fatalf("decode the request error: %v", err)
+	}
+	record := msg.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	if record.TimeUnixNano != 1700000000123456789 {
+		t.Errorf("the time is not decoded with the full precision: %d", record.TimeUnixNano)
+	}
+	if record.ObservedTimeUnixNano != 1700000000987654321 {
+		t.Errorf("the observed time is not decoded with the full precision: %d", record.ObservedTimeUnixNano)
+	}
+	if id := hex.EncodeToString(record.TraceId); id != "5b8efff798038103d269b633813fc60c" {
+		t.Errorf("the trace id is not decoded: %s", id)
+	}
+	if id := hex.EncodeToString(record.SpanId); id != "eee19b7ec3c1b174" {
+		t.Errorf("the span id is not decoded: %s", id)
+	}
+}
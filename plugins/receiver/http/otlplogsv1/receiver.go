@@ -0,0 +1,151 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlplogsv1
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	grpc_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlplogsv1"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	grpc_otlplogsv1 "github.com/apache/skywalking-satellite/plugins/receiver/grpc/otlplogsv1"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+	logs "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/logs/v1"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-otlp-logs-v1-receiver"
+	ShowName  = "HTTP OpenTelemetry Logs v1 Receiver"
+	eventName = "http-otlp-logs-v1-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	URI     string `mapstructure:"uri"`     // The OTLP logs request URI.
+	Timeout int    `mapstructure:"timeout"` // The request timeout seconds.
+	// Convert the OpenTelemetry log records to the SkyWalking native logs, which could be sent by the native log forwarders.
+	ConvertToNative bool `mapstructure:"convert_to_native"`
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the OpenTelemetry Logs v1 over HTTP(OTLP/HTTP), which accepts both the protobuf(application/x-protobuf) " +
+		"and the JSON encoding, the protocol is defined at https://opentelemetry.io/docs/specs/otlp/#otlphttp."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The OTLP logs request URI.
+uri: "/v1/logs"
+# The request timeout seconds.
+timeout: 5
+# Convert the OpenTelemetry log records to the SkyWalking native logs, which could be sent by the native log forwarders.
+# The trace ID is kept in the trace context, and the span ID is kept as the "span_id" tag.
+convert_to_native: false
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.URI, r.httpHandler())
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler() http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		data := &logs.ExportLogsServiceRequest{}
+		if err := http_receiver.DecodeOTLPRequest(req, data, r.Server.MaxBodyBytes()); err != nil {
+			log.Logger.Errorf("decode the otlp logs error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := r.send(data, req.RemoteAddr); err != nil {
+			http.Error(rsp, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http_receiver.WriteOTLPResponse(rsp, req, &logs.ExportLogsServiceResponse{})
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func (r *Receiver) send(data *logs.ExportLogsServiceRequest, peer string) error {
+	if len(data.GetResourceLogs()) == 0 {
+		return nil
+	}
+	if r.ConvertToNative {
+		if e := grpc_otlplogsv1.NewNativeLogsEvent(eventName, data); e != nil {
+			e.Meta = event.SourcePeerMeta(peer)
+			r.OutputChannel <- e
+		}
+		return nil
+	}
+	content, err := proto.Marshal(data)
+	if err != nil {
+		return err
+	}
+	r.OutputChannel <- event.NewExtensionEvent(eventName, event.OpenTelemetryLogsV1Type, content)
+	return nil
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(otlplogsv1.Forwarder),
+		new(grpc_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
+		new(kafka_nativelog.Forwarder),
+	}
+}
@@ -0,0 +1,130 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlplogsv1
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress = "localhost:12813"
+	jsonLogs    = `{"resourceLogs": [{
+  "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "test-service"}}]},
+  "scopeLogs": [{"logRecords": [{
+    "timeUnixNano": "1544712660000000000",
+    "severityText": "INFO",
+    "body": {"stringValue": "test log"},
+    "traceId": "5b8efff798038103d269b633813fc60c",
+    "spanId": "eee19b7ec3c1b174"
+  }]}]
+}]}`
+)
+
+func TestReceiver_ConvertToNative(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "application/json", []byte(jsonLogs), http.StatusOK)
+	e := <-r.Channel()
+	if e.GetType() != v1.SniffType_Logging || len(e.GetLogList().GetLogs()) != 1 {
+		t.Fatalf("the event should be a native log list with one log, but got %v", e)
+	}
+	received := &logging.LogData{}
+	if err := proto.Unmarshal(e.GetLogList().GetLogs()[0], received); err != nil {
+		t.Fatalf("cannot unmarshal the log: %v", err)
+	}
+	want := &logging.LogData{
+		Timestamp: 1544712660000,
+		Service:   "test-service",
+		Body: &logging.LogDataBody{
+			Type:    "text",
+			Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: "test log"}},
+		},
+		TraceContext: &logging.TraceContext{
+			TraceId: "5b8efff798038103d269b633813fc60c",
+		},
+		Tags: &logging.LogTags{Data: []*common.KeyStringValuePair{
+			{Key: "level", Value: "INFO"},
+			{Key: "span_id", Value: "eee19b7ec3c1b174"},
+		}},
+	}
+	if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received log is not equal to the sent log: %s", diff)
+	}
+}
+
+func TestReceiver_IllegalLogs(t *testing.T) {
+	startReceiver(t)
+	post(t, "application/json", []byte(`{"resourceLogs": [{"scopeLogs": [{"logRecords": [{"traceId": "not-hex"}]}]}]}`), http.StatusBadRequest)
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name, "convert_to_native": true})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, contentType string, data []byte, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s/v1/logs", testAddress), contentType, bytes.NewBuffer(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,121 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlpmetricsv1
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/grpc/otlpmetricsv1"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	metrics "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/collector/metrics/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-otlp-metrics-v1-receiver"
+	ShowName  = "HTTP OpenTelemetry Metrics v1 Receiver"
+	eventName = "http-otlp-metrics-v1-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	URI     string `mapstructure:"uri"`     // The OTLP metrics request URI.
+	Timeout int    `mapstructure:"timeout"` // The request timeout seconds.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the OpenTelemetry Metrics v1 over HTTP(OTLP/HTTP), which accepts both the protobuf(application/x-protobuf) " +
+		"and the JSON encoding, the protocol is defined at https://opentelemetry.io/docs/specs/otlp/#otlphttp."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The OTLP metrics request URI.
+uri: "/v1/metrics"
+# The request timeout seconds.
+timeout: 5
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.URI, r.httpHandler())
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler() http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		data := &metrics.ExportMetricsServiceRequest{}
+		if err := http_receiver.DecodeOTLPRequest(req, data, r.Server.MaxBodyBytes()); err != nil {
+			log.Logger.Errorf("decode the otlp metrics error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(data.GetResourceMetrics()) > 0 {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      nil,
+				Type:      v1.SniffType_OpenTelementryMetricsV1Type,
+				Remote:    true,
+				Data: &v1.SniffData_OpenTelementryMetricsV1Request{
+					OpenTelementryMetricsV1Request: data,
+				},
+			}
+		}
+		http_receiver.WriteOTLPResponse(rsp, req, &metrics.ExportMetricsServiceResponse{})
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(otlpmetricsv1.Forwarder),
+	}
+}
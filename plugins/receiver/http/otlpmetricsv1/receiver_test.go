@@ -0,0 +1,141 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlpmetricsv1
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+
+	metrics "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/collector/metrics/v1"
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	metricsv1 "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/metrics/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress = "localhost:12811"
+	jsonMetrics = `{"resourceMetrics": [{
+  "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "test-service"}}]},
+  "scopeMetrics": [{"metrics": [{
+    "name": "test.gauge",
+    "gauge": {"dataPoints": [{"asDouble": 1.5, "timeUnixNano": "1544712660000000000"}]}
+  }]}]
+}]}`
+)
+
+func TestReceiver_JSONMetrics(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "application/json", []byte(jsonMetrics), http.StatusOK)
+	e := <-r.Channel()
+	if e.GetType() != v1.SniffType_OpenTelementryMetricsV1Type {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_OpenTelementryMetricsV1Type, e.GetType())
+	}
+	if diff := cmp.Diff(initData(), e.GetOpenTelementryMetricsV1Request(), protocmp.Transform()); diff != "" {
+		t.Fatalf("the received metrics are not equal to the sent metrics: %s", diff)
+	}
+}
+
+func TestReceiver_ProtobufMetrics(t *testing.T) {
+	r := startReceiver(t)
+	data, err := proto.Marshal(initData())
+	if err != nil {
+		t.Fatalf("cannot marshal the metrics: %v", err)
+	}
+	go post(t, "application/x-protobuf", data, http.StatusOK)
+	e := <-r.Channel()
+	if diff := cmp.Diff(initData(), e.GetOpenTelementryMetricsV1Request(), protocmp.Transform()); diff != "" {
+		t.Fatalf("the received metrics are not equal to the sent metrics: %s", diff)
+	}
+}
+
+func TestReceiver_IllegalMetrics(t *testing.T) {
+	startReceiver(t)
+	post(t, "application/json", []byte(`{"resourceMetrics": "illegal"}`), http.StatusBadRequest)
+}
+
+func initData() *metrics.ExportMetricsServiceRequest {
+	return &metrics.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricsv1.ResourceMetrics{{
+			Resource: &resource.Resource{Attributes: []*common.KeyValue{{
+				Key:   "service.name",
+				Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: "test-service"}},
+			}}},
+			ScopeMetrics: []*metricsv1.ScopeMetrics{{Metrics: []*metricsv1.Metric{{
+				Name: "test.gauge",
+				Data: &metricsv1.Metric_Gauge{Gauge: &metricsv1.Gauge{DataPoints: []*metricsv1.NumberDataPoint{{
+					Value:        &metricsv1.NumberDataPoint_AsDouble{AsDouble: 1.5},
+					TimeUnixNano: 1544712660000000000,
+				}}}},
+			}}}},
+		}},
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, contentType string, data []byte, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s/v1/metrics", testAddress), contentType, bytes.NewBuffer(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,177 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlptracesv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+	trace "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/collector/trace/v1"
+	tracev1 "github.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/trace/v1"
+
+	common "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	resource "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+)
+
+const (
+	testAddress = "localhost:12812"
+	jsonTraces  = `{"resourceSpans": [{
+  "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "test-service"}}]},
+  "scopeSpans": [{"spans": [{
+    "traceId": "5b8efff798038103d269b633813fc60c",
+    "spanId": "eee19b7ec3c1b174",
+    "parentSpanId": "eee19b7ec3c1b173",
+    "name": "/test",
+    "kind": 2,
+    "startTimeUnixNano": "1544712660000000000",
+    "endTimeUnixNano": "1544712661000000000"
+  }]}]
+}]}`
+)
+
+func TestReceiver_JSONTraces(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "application/json", false, []byte(jsonTraces), http.StatusOK)
+	e := <-r.Channel()
+	if e.GetType() != event.OpenTelemetryTracesV1Type {
+		t.Fatalf("the event type should be %d, but got %d", event.OpenTelemetryTracesV1Type, e.GetType())
+	}
+	received := &trace.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(event.ExtensionData(e), received); err != nil {
+		t.Fatalf("cannot unmarshal the traces: %v", err)
+	}
+	want := initData([]byte{0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c},
+		[]byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74}, []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x73})
+	if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received traces are not equal to the sent traces: %s", diff)
+	}
+}
+
+func TestReceiver_GzipProtobufTraces(t *testing.T) {
+	r := startReceiver(t)
+	sent := initData([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []byte{1, 2, 3, 4, 5, 6, 7, 8}, nil)
+	data, err := proto.Marshal(sent)
+	if err != nil {
+		t.Fatalf("cannot marshal the traces: %v", err)
+	}
+	go post(t, "application/x-protobuf", true, data, http.StatusOK)
+	e := <-r.Channel()
+	received := &trace.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(event.ExtensionData(e), received); err != nil {
+		t.Fatalf("cannot unmarshal the traces: %v", err)
+	}
+	if diff := cmp.Diff(sent, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received traces are not equal to the sent traces: %s", diff)
+	}
+}
+
+func TestReceiver_IllegalTraces(t *testing.T) {
+	startReceiver(t)
+	post(t, "application/json", false, []byte(`{"resourceSpans": [{"scopeSpans": [{"spans": [{"traceId": "not-hex"}]}]}]}`), http.StatusBadRequest)
+}
+
+func initData(traceID, spanID, parentSpanID []byte) *trace.ExportTraceServiceRequest {
+	return &trace.ExportTraceServiceRequest{
+		ResourceSpans: []*tracev1.ResourceSpans{{
+			Resource: &resource.Resource{Attributes: []*common.KeyValue{{
+				Key:   "service.name",
+				Value: &common.AnyValue{Value: &common.AnyValue_StringValue{StringValue: "test-service"}},
+			}}},
+			ScopeSpans: []*tracev1.ScopeSpans{{Spans: []*tracev1.Span{{
+				TraceId:           traceID,
+				SpanId:            spanID,
+				ParentSpanId:      parentSpanID,
+				Name:              "/test",
+				Kind:              tracev1.Span_SPAN_KIND_SERVER,
+				StartTimeUnixNano: 1544712660000000000,
+				EndTimeUnixNano:   1544712661000000000,
+			}}}},
+		}},
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, contentType string, compress bool, data []byte, wantCode int) {
+	body := bytes.NewBuffer(nil)
+	if compress {
+		writer := gzip.NewWriter(body)
+		_, _ = writer.Write(data)
+		_ = writer.Close()
+	} else {
+		body.Write(data)
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/v1/traces", testAddress), body)
+	if err != nil {
+		t.Errorf("cannot build the request, error: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", contentType)
+	if compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,86 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"math"
+
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+const metricNameLabel = "__name__"
+
+type meterGroupKey struct {
+	service   string
+	instance  string
+	timestamp int64
+}
+
+// convertToMeterCollections converts the samples of the write request to the native meters,
+// the meters are grouped by the service, the service instance labels and the timestamp, as the OAP takes the
+// timestamp of the first meter as the time of the whole collection. The series without
+// the metric name or the service label are ignored, and so are the stale markers(NaN samples).
+func convertToMeterCollections(req *prompb.WriteRequest, serviceLabel, instanceLabel string) []*meter.MeterDataCollection {
+	collections := make([]*meter.MeterDataCollection, 0)
+	groups := make(map[meterGroupKey]*meter.MeterDataCollection)
+	for _, series := range req.GetTimeseries() {
+		var name string
+		key := meterGroupKey{}
+		labels := make([]*meter.Label, 0, len(series.GetLabels()))
+		for _, l := range series.GetLabels() {
+			switch l.GetName() {
+			case metricNameLabel:
+				name = l.GetValue()
+			case serviceLabel:
+				key.service = l.GetValue()
+			case instanceLabel:
+				key.instance = l.GetValue()
+			default:
+				labels = append(labels, &meter.Label{Name: l.GetName(), Value: l.GetValue()})
+			}
+		}
+		if name == "" || key.service == "" {
+			continue
+		}
+		for _, sample := range series.GetSamples() {
+			if math.IsNaN(sample.GetValue()) {
+				continue
+			}
+			sampleKey := meterGroupKey{service: key.service, instance: key.instance, timestamp: sample.GetTimestamp()}
+			collection, ok := groups[sampleKey]
+			if !ok {
+				collection = &meter.MeterDataCollection{}
+				groups[sampleKey] = collection
+				collections = append(collections, collection)
+			}
+			collection.MeterData = append(collection.MeterData, &meter.MeterData{
+				Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+					Name:   name,
+					Labels: labels,
+					Value:  sample.GetValue(),
+				}},
+				Service:         key.service,
+				ServiceInstance: key.instance,
+				Timestamp:       sample.GetTimestamp(),
+			})
+		}
+	}
+	return collections
+}
@@ -0,0 +1,142 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemeter"
+	kafka_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemeter"
+	otlp_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativemeter"
+	prometheusremotewrite_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/nativemeter"
+	http_receiver "github.com/apache/skywalking-satellite/plugins/receiver/http"
+	http_server "github.com/apache/skywalking-satellite/plugins/server/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "http-prometheus-remote-write-receiver"
+	ShowName  = "HTTP Prometheus Remote Write Receiver"
+	eventName = "http-prometheus-remote-write-event"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	URI           string `mapstructure:"uri"`            // The remote write request URI.
+	Timeout       int    `mapstructure:"timeout"`        // The request timeout seconds.
+	ServiceLabel  string `mapstructure:"service_label"`  // The label name mapping to the service name.
+	InstanceLabel string `mapstructure:"instance_label"` // The label name mapping to the service instance name.
+	// components
+	Server        *http_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the Prometheus remote write protocol(snappy compressed protobuf WriteRequest), " +
+		"the samples are converted to the SkyWalking native meters and grouped by the service, the service instance labels and the timestamp. " +
+		"The series without the service label are dropped, and the native histograms are not supported yet."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The remote write request URI.
+uri: "/api/v1/write"
+# The request timeout seconds.
+timeout: 5
+# The label name mapping to the service name.
+service_label: "job"
+# The label name mapping to the service instance name.
+instance_label: "instance"
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*http_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.Server.Handle(r.URI, r.httpHandler())
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) httpHandler() http.Handler {
+	h := http.HandlerFunc(func(rsp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(rsp, "only the POST method is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := http_receiver.ReadBody(req, r.Server.MaxBodyBytes())
+		if err != nil {
+			log.Logger.Errorf("read the remote write request error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data := &prompb.WriteRequest{}
+		if err := proto.Unmarshal(body, data); err != nil {
+			log.Logger.Errorf("decode the remote write request error: %v", err)
+			http.Error(rsp, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, collection := range convertToMeterCollections(data, r.ServiceLabel, r.InstanceLabel) {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      nil,
+				Type:      v1.SniffType_MeterType,
+				Remote:    true,
+				Data: &v1.SniffData_MeterCollection{
+					MeterCollection: collection,
+				},
+			}
+		}
+		rsp.WriteHeader(http.StatusNoContent)
+	})
+	return http.TimeoutHandler(h, time.Duration(r.Timeout)*time.Second, fmt.Sprintf("Exceeded configured timeout of %d seconds", r.Timeout))
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativemeter.Forwarder),
+		new(kafka_nativemeter.Forwarder),
+		new(prometheusremotewrite_nativemeter.Forwarder),
+		new(otlp_nativemeter.Forwarder),
+	}
+}
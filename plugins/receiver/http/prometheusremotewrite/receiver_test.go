@@ -0,0 +1,174 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheusremotewrite
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+	"github.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const testAddress = "localhost:12819"
+
+func TestReceiver_RemoteWrite(t *testing.T) {
+	r := startReceiver(t)
+	data, err := proto.Marshal(initData())
+	if err != nil {
+		t.Fatalf("cannot marshal the write request: %v", err)
+	}
+	go post(t, snappy.Encode(nil, data), http.StatusNoContent)
+	expected := []*meter.MeterDataCollection{
+		{MeterData: []*meter.MeterData{
+			singleValue("http_requests_total", "svc-a", "instance-1", 10, 1000, &meter.Label{Name: "code", Value: "200"}),
+			singleValue("process_open_fds", "svc-a", "instance-1", 8, 1000),
+		}},
+		{MeterData: []*meter.MeterData{
+			singleValue("http_requests_total", "svc-a", "instance-1", 12, 2000, &meter.Label{Name: "code", Value: "200"}),
+		}},
+		{MeterData: []*meter.MeterData{
+			singleValue("up", "svc-b", "instance-2", 1, 1000),
+		}},
+	}
+	for _, want := range expected {
+		e := <-r.Channel()
+		if e.GetType() != v1.SniffType_MeterType {
+			t.Fatalf("the event type should be %d, but got %d", v1.SniffType_MeterType, e.GetType())
+		}
+		if diff := cmp.Diff(want, e.GetMeterCollection(), protocmp.Transform()); diff != "" {
+			t.Fatalf("the converted meters are not as expected: %s", diff)
+		}
+	}
+}
+
+func TestReceiver_IllegalRequest(t *testing.T) {
+	startReceiver(t)
+	post(t, []byte("illegal"), http.StatusBadRequest)
+}
+
+func initData() *prompb.WriteRequest {
+	return &prompb.WriteRequest{Timeseries: []*prompb.TimeSeries{
+		{
+			Labels: []*prompb.Label{
+				{Name: "__name__", Value: "http_requests_total"},
+				{Name: "job", Value: "svc-a"},
+				{Name: "instance", Value: "instance-1"},
+				{Name: "code", Value: "200"},
+			},
+			Samples: []*prompb.Sample{{Value: 10, Timestamp: 1000}, {Value: 12, Timestamp: 2000}, {Value: math.NaN(), Timestamp: 3000}},
+		},
+		{
+			Labels: []*prompb.Label{
+				{Name: "__name__", Value: "process_open_fds"},
+				{Name: "job", Value: "svc-a"},
+				{Name: "instance", Value: "instance-1"},
+			},
+			Samples: []*prompb.Sample{{Value: 8, Timestamp: 1000}},
+		},
+		{
+			Labels: []*prompb.Label{
+				{Name: "__name__", Value: "up"},
+				{Name: "job", Value: "svc-b"},
+				{Name: "instance", Value: "instance-2"},
+			},
+			Samples: []*prompb.Sample{{Value: 1, Timestamp: 1000}},
+		},
+		{
+			Labels:  []*prompb.Label{{Name: "__name__", Value: "no_service"}},
+			Samples: []*prompb.Sample{{Value: 1, Timestamp: 1000}},
+		},
+	}}
+}
+
+func singleValue(name, service, instance string, value float64, timestamp int64, labels ...*meter.Label) *meter.MeterData {
+	return &meter.MeterData{
+		Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+			Name:   name,
+			Labels: labels,
+			Value:  value,
+		}},
+		Service:         service,
+		ServiceInstance: instance,
+		Timestamp:       timestamp,
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, data []byte, wantCode int) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/api/v1/write", testAddress), bytes.NewBuffer(data))
+	if err != nil {
+		t.Errorf("cannot create the request: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -0,0 +1,153 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zipkinv2
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	zipkin "github.com/apache/skywalking-satellite/protocol/gen-codes/zipkin/proto3"
+)
+
+// jsonSpan is the Zipkin v2 span in the JSON encoding.
+type jsonSpan struct {
+	TraceID        string            `json:"traceId"`
+	ParentID       string            `json:"parentId"`
+	ID             string            `json:"id"`
+	Kind           string            `json:"kind"`
+	Name           string            `json:"name"`
+	Timestamp      uint64            `json:"timestamp"`
+	Duration       uint64            `json:"duration"`
+	LocalEndpoint  *jsonEndpoint     `json:"localEndpoint"`
+	RemoteEndpoint *jsonEndpoint     `json:"remoteEndpoint"`
+	Annotations    []*jsonAnnotation `json:"annotations"`
+	Tags           map[string]string `json:"tags"`
+	Debug          bool              `json:"debug"`
+	Shared         bool              `json:"shared"`
+}
+
+type jsonEndpoint struct {
+	ServiceName string `json:"serviceName"`
+	IPv4        string `json:"ipv4"`
+	IPv6        string `json:"ipv6"`
+	Port        int32  `json:"port"`
+}
+
+type jsonAnnotation struct {
+	Timestamp uint64 `json:"timestamp"`
+	Value     string `json:"value"`
+}
+
+// unmarshalJSONSpans converts the JSON encoded spans to the protobuf spans.
+func unmarshalJSONSpans(body []byte) (*zipkin.ListOfSpans, error) {
+	var spans []*jsonSpan
+	if err := json.Unmarshal(body, &spans); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal the json spans: %v", err)
+	}
+	result := &zipkin.ListOfSpans{Spans: make([]*zipkin.Span, 0, len(spans))}
+	for _, s := range spans {
+		if s == nil {
+			continue
+		}
+		span, err := s.toProto()
+		if err != nil {
+			return nil, err
+		}
+		result.Spans = append(result.Spans, span)
+	}
+	return result, nil
+}
+
+func (s *jsonSpan) toProto() (*zipkin.Span, error) {
+	traceID, err := decodeID(s.TraceID, "traceId", true)
+	if err != nil {
+		return nil, err
+	}
+	id, err := decodeID(s.ID, "id", true)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := decodeID(s.ParentID, "parentId", false)
+	if err != nil {
+		return nil, err
+	}
+	kind, ok := zipkin.Span_Kind_value[strings.ToUpper(s.Kind)]
+	if !ok && s.Kind != "" {
+		return nil, fmt.Errorf("unknown span kind: %s", s.Kind)
+	}
+	span := &zipkin.Span{
+		TraceId:        traceID,
+		ParentId:       parentID,
+		Id:             id,
+		Kind:           zipkin.Span_Kind(kind),
+		Name:           s.Name,
+		Timestamp:      s.Timestamp,
+		Duration:       s.Duration,
+		LocalEndpoint:  s.LocalEndpoint.toProto(),
+		RemoteEndpoint: s.RemoteEndpoint.toProto(),
+		Tags:           s.Tags,
+		Debug:          s.Debug,
+		Shared:         s.Shared,
+	}
+	for _, a := range s.Annotations {
+		if a == nil {
+			continue
+		}
+		span.Annotations = append(span.Annotations, &zipkin.Annotation{Timestamp: a.Timestamp, Value: a.Value})
+	}
+	return span, nil
+}
+
+func (e *jsonEndpoint) toProto() *zipkin.Endpoint {
+	if e == nil {
+		return nil
+	}
+	endpoint := &zipkin.Endpoint{ServiceName: e.ServiceName, Port: e.Port}
+	if ip := net.ParseIP(e.IPv4).To4(); ip != nil {
+		endpoint.Ipv4 = ip
+	}
+	if ip := net.ParseIP(e.IPv6); ip != nil && ip.To4() == nil {
+		endpoint.Ipv6 = ip
+	}
+	return endpoint
+}
+
+// decodeID decodes the lower-hex ID, the ID shorter than 16 or 32 characters would be left-padded with zeros.
+func decodeID(id, field string, required bool) ([]byte, error) {
+	if id == "" {
+		if required {
+			return nil, fmt.Errorf("the %s is required", field)
+		}
+		return nil, nil
+	}
+	if len(id) > 32 {
+		return nil, fmt.Errorf("the %s is longer than 32 characters: %s", field, id)
+	}
+	width := 16
+	if len(id) > 16 {
+		width = 32
+	}
+	result, err := hex.DecodeString(strings.Repeat("0", width-len(id)) + id)
+	if err != nil {
+		return nil, fmt.Errorf("the %s is not a hex string: %s", field, id)
+	}
+	return result, nil
+}
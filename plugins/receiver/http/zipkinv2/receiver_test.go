@@ -0,0 +1,151 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zipkinv2
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	httpserver "github.com/apache/skywalking-satellite/plugins/server/http"
+	zipkin "github.com/apache/skywalking-satellite/protocol/gen-codes/zipkin/proto3"
+)
+
+const (
+	testAddress = "localhost:12810"
+	jsonSpans   = `[{
+  "traceId": "5af7183fb1d4cf5f",
+  "parentId": "6b221d5bc9e6496c",
+  "id": "352bff9a74ca9ad2",
+  "kind": "CLIENT",
+  "name": "get /api",
+  "timestamp": 1556604172355737,
+  "duration": 1431,
+  "localEndpoint": {"serviceName": "frontend", "ipv4": "192.168.99.1", "port": 8080},
+  "remoteEndpoint": {"serviceName": "backend", "ipv6": "2001:db8::c001", "port": 9000},
+  "annotations": [{"timestamp": 1556604172355738, "value": "ws"}],
+  "tags": {"http.method": "GET", "http.path": "/api"}
+}]`
+)
+
+func TestReceiver_JSONSpans(t *testing.T) {
+	r := startReceiver(t)
+	go post(t, "application/json", []byte(jsonSpans), http.StatusAccepted)
+	e := <-r.Channel()
+	if e.GetType() != event.ZipkinSpansType {
+		t.Fatalf("the event type should be %d, but got %d", event.ZipkinSpansType, e.GetType())
+	}
+	spans := &zipkin.ListOfSpans{}
+	if err := proto.Unmarshal(event.ExtensionData(e), spans); err != nil {
+		t.Fatalf("cannot unmarshal the spans: %v", err)
+	}
+	want := &zipkin.ListOfSpans{Spans: []*zipkin.Span{{
+		TraceId:        []byte{0x5a, 0xf7, 0x18, 0x3f, 0xb1, 0xd4, 0xcf, 0x5f},
+		ParentId:       []byte{0x6b, 0x22, 0x1d, 0x5b, 0xc9, 0xe6, 0x49, 0x6c},
+		Id:             []byte{0x35, 0x2b, 0xff, 0x9a, 0x74, 0xca, 0x9a, 0xd2},
+		Kind:           zipkin.Span_CLIENT,
+		Name:           "get /api",
+		Timestamp:      1556604172355737,
+		Duration:       1431,
+		LocalEndpoint:  &zipkin.Endpoint{ServiceName: "frontend", Ipv4: []byte{192, 168, 99, 1}, Port: 8080},
+		RemoteEndpoint: &zipkin.Endpoint{ServiceName: "backend", Ipv6: []byte{0x20, 0x01, 0x0d, 0xb8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xc0, 0x01}, Port: 9000},
+		Annotations:    []*zipkin.Annotation{{Timestamp: 1556604172355738, Value: "ws"}},
+		Tags:           map[string]string{"http.method": "GET", "http.path": "/api"},
+	}}}
+	if diff := cmp.Diff(want, spans, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received spans are not equal to the sent spans: %s", diff)
+	}
+}
+
+func TestReceiver_ProtobufSpans(t *testing.T) {
+	r := startReceiver(t)
+	spans := &zipkin.ListOfSpans{Spans: []*zipkin.Span{{
+		TraceId: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		Id:      []byte{1, 2, 3, 4, 5, 6, 7, 9},
+		Name:    "proto-span",
+	}}}
+	data, err := proto.Marshal(spans)
+	if err != nil {
+		t.Fatalf("cannot marshal the spans: %v", err)
+	}
+	go post(t, "application/x-protobuf", data, http.StatusAccepted)
+	e := <-r.Channel()
+	received := &zipkin.ListOfSpans{}
+	if err := proto.Unmarshal(event.ExtensionData(e), received); err != nil {
+		t.Fatalf("cannot unmarshal the spans: %v", err)
+	}
+	if diff := cmp.Diff(spans, received, protocmp.Transform()); diff != "" {
+		t.Fatalf("the received spans are not equal to the sent spans: %s", diff)
+	}
+}
+
+func TestReceiver_IllegalSpans(t *testing.T) {
+	startReceiver(t)
+	post(t, "application/json", []byte(`[{"traceId": "not-hex", "id": "1"}]`), http.StatusBadRequest)
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(httpserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: httpserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the http server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the http server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
+
+func post(t *testing.T, contentType string, data []byte, wantCode int) {
+	client := http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Post(fmt.Sprintf("http://%s/api/v2/spans", testAddress), contentType, bytes.NewBuffer(data))
+	if err != nil {
+		t.Errorf("cannot request the http-server, error: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantCode {
+		t.Errorf("the response code should be %d, but got %d", wantCode, resp.StatusCode)
+	}
+}
@@ -22,11 +22,13 @@ import (
 
 	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
 	"github.com/apache/skywalking-satellite/plugins/receiver/api"
+	"github.com/apache/skywalking-satellite/plugins/receiver/fluentforward"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/envoyalsv2"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/envoyalsv3"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/envoymetricsv2"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/envoymetricsv3"
 	grpcasyncprofiler "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativeasyncprofiler"
+	grpcnativebrowser "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativebrowser"
 	grpcnativecds "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativecds"
 	grpcnativeclr "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativeclr"
 	grpcnativeebpfaccesslog "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativeebpfaccesslog"
@@ -39,8 +41,23 @@ import (
 	grpcnativeprocess "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativeprocess"
 	grpcnativeprofile "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativeprofile"
 	grpcnativetracing "github.com/apache/skywalking-satellite/plugins/receiver/grpc/nativetracing"
+	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/otlplogsv1"
 	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/otlpmetricsv1"
+	"github.com/apache/skywalking-satellite/plugins/receiver/grpc/otlptracesv1"
 	httpnavtivelog "github.com/apache/skywalking-satellite/plugins/receiver/http/nativcelog"
+	httpnativebrowser "github.com/apache/skywalking-satellite/plugins/receiver/http/nativebrowser"
+	httpnativeevent "github.com/apache/skywalking-satellite/plugins/receiver/http/nativeevent"
+	httpnativelogrest "github.com/apache/skywalking-satellite/plugins/receiver/http/nativelogrest"
+	httpnativemanagement "github.com/apache/skywalking-satellite/plugins/receiver/http/nativemanagement"
+	httpnativetracing "github.com/apache/skywalking-satellite/plugins/receiver/http/nativetracing"
+	httpotlplogsv1 "github.com/apache/skywalking-satellite/plugins/receiver/http/otlplogsv1"
+	httpotlpmetricsv1 "github.com/apache/skywalking-satellite/plugins/receiver/http/otlpmetricsv1"
+	httpotlptracesv1 "github.com/apache/skywalking-satellite/plugins/receiver/http/otlptracesv1"
+	httpprometheusremotewrite "github.com/apache/skywalking-satellite/plugins/receiver/http/prometheusremotewrite"
+	httpzipkinv2 "github.com/apache/skywalking-satellite/plugins/receiver/http/zipkinv2"
+	"github.com/apache/skywalking-satellite/plugins/receiver/statsd"
+	"github.com/apache/skywalking-satellite/plugins/receiver/syslog"
+	"github.com/apache/skywalking-satellite/plugins/receiver/zabbix"
 )
 
 // RegisterReceiverPlugins register the used receiver plugins.
@@ -67,6 +84,23 @@ func RegisterReceiverPlugins() {
 		new(otlpmetricsv1.Receiver),
 		new(grpcnativeebpfaccesslog.Receiver),
 		new(grpcasyncprofiler.Receiver),
+		new(httpzipkinv2.Receiver),
+		new(otlptracesv1.Receiver),
+		new(otlplogsv1.Receiver),
+		new(httpotlpmetricsv1.Receiver),
+		new(httpotlptracesv1.Receiver),
+		new(httpotlplogsv1.Receiver),
+		new(grpcnativebrowser.Receiver),
+		new(httpnativebrowser.Receiver),
+		new(httpnativelogrest.Receiver),
+		new(httpnativetracing.Receiver),
+		new(httpnativemanagement.Receiver),
+		new(httpnativeevent.Receiver),
+		new(httpprometheusremotewrite.Receiver),
+		new(syslog.Receiver),
+		new(fluentforward.Receiver),
+		new(statsd.Receiver),
+		new(zabbix.Receiver),
 	}
 	for _, receiver := range receivers {
 		plugin.RegisterPlugin(receiver)
@@ -0,0 +1,210 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+)
+
+// aggregation is the aggregated value of a meter in the flush interval. The counters and the histograms are
+// cumulative as the native meters of the SkyWalking agents, the gauges keep the last value, and the sets
+// are the count of the unique values in the flush interval. The idle is the count of the flushes without updates.
+type aggregation struct {
+	metricType string
+	service    string
+	instance   string
+	name       string
+	labels     []*meter.Label
+	value      float64
+	counts     []float64
+	set        map[string]struct{}
+	updated    bool
+	idle       int
+}
+
+type aggregator struct {
+	lock         sync.Mutex
+	buckets      []float64
+	serviceTag   string
+	instanceTag  string
+	maxIdle      int
+	aggregations map[string]*aggregation
+}
+
+func newAggregator(buckets []float64, serviceTag, instanceTag string, maxIdle int) *aggregator {
+	return &aggregator{
+		buckets:      buckets,
+		serviceTag:   serviceTag,
+		instanceTag:  instanceTag,
+		maxIdle:      maxIdle,
+		aggregations: make(map[string]*aggregation),
+	}
+}
+
+// add aggregates the metric, the service and the instance are used when the metric does not have the mapping tags.
+func (a *aggregator) add(m *metric, service, instance string) error {
+	labels := make([]*meter.Label, 0, len(m.tags))
+	for k, v := range m.tags {
+		switch k {
+		case a.serviceTag:
+			service = v
+		case a.instanceTag:
+			instance = v
+		default:
+			labels = append(labels, &meter.Label{Name: k, Value: v})
+		}
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].Name < labels[j].Name
+	})
+	name := meterName(m.name)
+	key := aggregationKey(service, instance, name, m.metricType, labels)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	agg, ok := a.aggregations[key]
+	if !ok {
+		agg = &aggregation{metricType: m.metricType, service: service, instance: instance, name: name, labels: labels}
+		a.aggregations[key] = agg
+	}
+	for _, raw := range m.values {
+		if err := a.aggregate(agg, raw, m.sampleRate); err != nil {
+			return err
+		}
+	}
+	agg.updated = true
+	agg.idle = 0
+	return nil
+}
+
+func (a *aggregator) aggregate(agg *aggregation, raw string, sampleRate float64) error {
+	if agg.metricType == setType {
+		if agg.set == nil {
+			agg.set = make(map[string]struct{})
+		}
+		agg.set[raw] = struct{}{}
+		return nil
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("illegal metric value: %s", raw)
+	}
+	switch agg.metricType {
+	case counterType:
+		agg.value += value / sampleRate
+	case gaugeType:
+		// the signed value means the delta of the gauge
+		if strings.HasPrefix(raw, "+") || strings.HasPrefix(raw, "-") {
+			agg.value += value
+		} else {
+			agg.value = value
+		}
+	default:
+		if agg.counts == nil {
+			agg.counts = make([]float64, len(a.buckets)+1)
+		}
+		agg.counts[sort.SearchFloat64s(a.buckets, math.Nextafter(value, math.Inf(1)))] += 1 / sampleRate
+	}
+	return nil
+}
+
+// flush builds the meters updated in the flush interval, the meters are grouped by the service and the instance.
+// The meters without updates in the max idle intervals are evicted.
+func (a *aggregator) flush(timestamp int64) []*meter.MeterDataCollection {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	keys := make([]string, 0, len(a.aggregations))
+	for key, agg := range a.aggregations {
+		if agg.updated {
+			keys = append(keys, key)
+			continue
+		}
+		agg.idle++
+		if agg.idle >= a.maxIdle {
+			delete(a.aggregations, key)
+		}
+	}
+	sort.Strings(keys)
+
+	collections := make([]*meter.MeterDataCollection, 0)
+	groups := make(map[string]*meter.MeterDataCollection)
+	for _, key := range keys {
+		agg := a.aggregations[key]
+		agg.updated = false
+		groupKey := agg.service + "\x00" + agg.instance
+		collection, ok := groups[groupKey]
+		if !ok {
+			collection = &meter.MeterDataCollection{}
+			groups[groupKey] = collection
+			collections = append(collections, collection)
+		}
+		data := &meter.MeterData{Service: agg.service, ServiceInstance: agg.instance, Timestamp: timestamp}
+		switch agg.metricType {
+		case counterType, gaugeType:
+			data.Metric = &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{Name: agg.name, Labels: agg.labels, Value: agg.value}}
+		case setType:
+			data.Metric = &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{Name: agg.name, Labels: agg.labels, Value: float64(len(agg.set))}}
+			delete(a.aggregations, key)
+		default:
+			data.Metric = &meter.MeterData_Histogram{Histogram: &meter.MeterHistogram{Name: agg.name, Labels: agg.labels, Values: a.bucketValues(agg.counts)}}
+		}
+		collection.MeterData = append(collection.MeterData, data)
+	}
+	return collections
+}
+
+func (a *aggregator) bucketValues(counts []float64) []*meter.MeterBucketValue {
+	values := make([]*meter.MeterBucketValue, 0, len(counts))
+	values = append(values, &meter.MeterBucketValue{IsNegativeInfinity: true, Count: int64(math.Round(counts[0]))})
+	for i, bucket := range a.buckets {
+		values = append(values, &meter.MeterBucketValue{Bucket: bucket, Count: int64(math.Round(counts[i+1]))})
+	}
+	return values
+}
+
+func aggregationKey(service, instance, name, metricType string, labels []*meter.Label) string {
+	var builder strings.Builder
+	for _, s := range []string{service, instance, name, metricType} {
+		builder.WriteString(s)
+		builder.WriteByte(0)
+	}
+	for _, l := range labels {
+		builder.WriteString(l.Name)
+		builder.WriteByte('=')
+		builder.WriteString(l.Value)
+		builder.WriteByte(0)
+	}
+	return builder.String()
+}
+
+// meterName replaces the characters which are not allowed in the meter name with the underscore.
+func meterName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
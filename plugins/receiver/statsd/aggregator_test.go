@@ -0,0 +1,62 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"testing"
+)
+
+func TestAggregator_EvictIdleMeters(t *testing.T) {
+	a := newAggregator([]float64{10, 100}, "service", "host", 2)
+	add := func(line string) {
+		m, err := parseLine(line)
+		if err != nil {
+			t.Fatalf("cannot parse the line %s: %v", line, err)
+		}
+		if err := a.add(m, "svc", "ins"); err != nil {
+			t.Fatalf("cannot aggregate the line %s: %v", line, err)
+		}
+	}
+	add("requests:2|c")
+	add("queue.size:5|g")
+	add("latency:20|ms")
+	if meters := len(a.flush(1)[0].MeterData); meters != 3 {
+		t.Fatalf("expected 3 meters, but got %d", meters)
+	}
+
+	// the counter keeps updating, the others are idle in 2 intervals.
+	add("requests:1|c")
+	a.flush(2)
+	if len(a.aggregations) != 3 {
+		t.Fatalf("expected the idle meters are kept in the max idle intervals, but got %d", len(a.aggregations))
+	}
+	add("requests:1|c")
+	collections := a.flush(3)
+	if len(a.aggregations) != 1 {
+		t.Fatalf("expected the idle meters are evicted, but got %d", len(a.aggregations))
+	}
+	if value := collections[0].MeterData[0].GetSingleValue().GetValue(); value != 4 {
+		t.Errorf("expected the counter is cumulative, but got %f", value)
+	}
+
+	// the evicted meter restarts from zero.
+	add("queue.size:+1|g")
+	if value := a.flush(4)[0].MeterData[0].GetSingleValue().GetValue(); value != 1 {
+		t.Errorf("expected the evicted gauge restarts from zero, but got %f", value)
+	}
+}
@@ -0,0 +1,96 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	counterType      = "c"
+	gaugeType        = "g"
+	timerType        = "ms"
+	histogramType    = "h"
+	distributionType = "d"
+	setType          = "s"
+)
+
+// metric is a parsed StatsD line: <name>:<value>[:<value>...]|<type>[|@<sample_rate>][|#<tag>:<value>,...].
+type metric struct {
+	name       string
+	metricType string
+	values     []string
+	sampleRate float64
+	tags       map[string]string
+}
+
+// parseLine parses the StatsD line with the DogStatsD extensions, return nil when the line is a DogStatsD event
+// or service check, which could not be converted to the meters.
+func parseLine(line string) (*metric, error) {
+	if strings.HasPrefix(line, "_e{") || strings.HasPrefix(line, "_sc|") {
+		return nil, nil
+	}
+	sections := strings.Split(line, "|")
+	if len(sections) < 2 {
+		return nil, fmt.Errorf("the metric type is missing")
+	}
+	nameIndex := strings.IndexByte(sections[0], ':')
+	if nameIndex <= 0 || nameIndex == len(sections[0])-1 {
+		return nil, fmt.Errorf("the metric name or value is missing")
+	}
+	m := &metric{
+		name:       sections[0][:nameIndex],
+		metricType: sections[1],
+		sampleRate: 1,
+		tags:       make(map[string]string),
+	}
+	switch m.metricType {
+	case setType:
+		// the set values could contain the colon
+		m.values = []string{sections[0][nameIndex+1:]}
+	case counterType, gaugeType, timerType, histogramType, distributionType:
+		m.values = strings.Split(sections[0][nameIndex+1:], ":")
+	default:
+		return nil, fmt.Errorf("unsupported metric type: %s", m.metricType)
+	}
+	for _, section := range sections[2:] {
+		switch {
+		case strings.HasPrefix(section, "@"):
+			rate, err := strconv.ParseFloat(section[1:], 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return nil, fmt.Errorf("illegal sample rate: %s", section)
+			}
+			m.sampleRate = rate
+		case strings.HasPrefix(section, "#"):
+			for _, tag := range strings.Split(section[1:], ",") {
+				if tag == "" {
+					continue
+				}
+				if index := strings.IndexByte(tag, ':'); index > 0 {
+					m.tags[tag[:index]] = tag[index+1:]
+				} else {
+					m.tags[tag] = ""
+				}
+			}
+		}
+		// the other DogStatsD extensions such as the container id and the timestamp are ignored
+	}
+	return m, nil
+}
@@ -0,0 +1,79 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    *metric
+		wantErr bool
+	}{
+		{
+			name: "counter with sample rate and tags",
+			line: "page.views:2|c|@0.5|#service:web,env:prod,canary",
+			want: &metric{name: "page.views", metricType: counterType, values: []string{"2"}, sampleRate: 0.5,
+				tags: map[string]string{"service": "web", "env": "prod", "canary": ""}},
+		},
+		{
+			name: "timer with multiple values",
+			line: "request.latency:320:150|ms",
+			want: &metric{name: "request.latency", metricType: timerType, values: []string{"320", "150"}, sampleRate: 1, tags: map[string]string{}},
+		},
+		{
+			name: "set with colon value and container id",
+			line: "users.unique:user:1|s|c:abcdef",
+			want: &metric{name: "users.unique", metricType: setType, values: []string{"user:1"}, sampleRate: 1, tags: map[string]string{}},
+		},
+		{
+			name: "dogstatsd event",
+			line: "_e{5,4}:title|text",
+		},
+		{
+			name:    "unsupported type",
+			line:    "gauge:1|x",
+			wantErr: true,
+		},
+		{
+			name:    "missing value",
+			line:    "gauge|g",
+			wantErr: true,
+		},
+		{
+			name:    "illegal sample rate",
+			line:    "counter:1|c|@2",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
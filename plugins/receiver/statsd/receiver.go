@@ -0,0 +1,183 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemeter"
+	kafka_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemeter"
+	otlp_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativemeter"
+	prometheusremotewrite_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/nativemeter"
+	statsd_server "github.com/apache/skywalking-satellite/plugins/server/statsd"
+
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "statsd-receiver"
+	ShowName  = "StatsD Receiver"
+	eventName = "statsd-event"
+
+	defaultHistogramBuckets = "5,10,25,50,100,250,500,1000,2500,5000,10000"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	FlushInterval    int    `mapstructure:"flush_interval"`    // The flush interval seconds of the aggregated meters.
+	IdleIntervals    int    `mapstructure:"idle_intervals"`    // The flush intervals without updates before the aggregated meter is evicted.
+	ServiceTag       string `mapstructure:"service_tag"`       // The tag name mapping to the service name.
+	InstanceTag      string `mapstructure:"instance_tag"`      // The tag name mapping to the service instance name.
+	DefaultService   string `mapstructure:"default_service"`   // The service name of the metrics without the service tag.
+	HistogramBuckets string `mapstructure:"histogram_buckets"` // The bucket boundaries of the timers and the histograms, split by ",".
+	// components
+	Server        *statsd_server.Server
+	OutputChannel chan *v1.SniffData
+	aggregator    *aggregator
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the StatsD metrics with the DogStatsD tags, which aggregates the counters, gauges, timers, histograms, " +
+		"distributions and sets in the flush interval and converts them to the SkyWalking native meters. The counters and the histograms are cumulative, " +
+		"the gauges keep the last value, and the sets are the count of the unique values in the flush interval. " +
+		"The service instance is the remote address when the metric does not have the instance tag. " +
+		"The aggregated meters without updates in the idle intervals are evicted, and restart from zero when they are received again."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The flush interval seconds of the aggregated meters.
+flush_interval: 10
+# The count of the flush intervals without updates before the aggregated meter is evicted, to bound the memory of the short-lived meters.
+idle_intervals: 30
+# The tag name mapping to the service name.
+service_tag: "service"
+# The tag name mapping to the service instance name.
+instance_tag: "host"
+# The service name of the metrics without the service tag.
+default_service: "statsd"
+# The bucket boundaries of the timers and the histograms, split by ",".
+histogram_buckets: "` + defaultHistogramBuckets + `"
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*statsd_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	buckets, err := parseBuckets(r.HistogramBuckets)
+	if err != nil {
+		log.Logger.Errorf("%s has illegal histogram buckets, the default buckets are used: %v", r.Name(), err)
+		buckets, _ = parseBuckets(defaultHistogramBuckets)
+	}
+	if r.FlushInterval <= 0 {
+		log.Logger.Errorf("%s has illegal flush interval %d, the interval is reset to 10 seconds", r.Name(), r.FlushInterval)
+		r.FlushInterval = 10
+	}
+	if r.IdleIntervals <= 0 {
+		log.Logger.Errorf("%s has illegal idle intervals %d, the idle intervals are reset to 30", r.Name(), r.IdleIntervals)
+		r.IdleIntervals = 30
+	}
+	r.aggregator = newAggregator(buckets, r.ServiceTag, r.InstanceTag, r.IdleIntervals)
+	r.Server.RegisterHandler(r.handleLine)
+	go r.flushLoop()
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) handleLine(line []byte, remote net.Addr) {
+	m, err := parseLine(string(line))
+	if err == nil && m != nil {
+		err = r.aggregator.add(m, r.DefaultService, remoteHost(remote))
+	}
+	if err != nil {
+		log.Logger.WithField("remote", remote.String()).Warnf("%s drops the illegal line %q: %v", r.Name(), line, err)
+	}
+}
+
+func (r *Receiver) flushLoop() {
+	ticker := time.NewTicker(time.Duration(r.FlushInterval) * time.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		for _, collection := range r.aggregator.flush(now.UnixNano() / 1e6) {
+			r.OutputChannel <- &v1.SniffData{
+				Name:      eventName,
+				Timestamp: time.Now().UnixNano() / 1e6,
+				Meta:      nil,
+				Type:      v1.SniffType_MeterType,
+				Remote:    true,
+				Data: &v1.SniffData_MeterCollection{
+					MeterCollection: collection,
+				},
+			}
+		}
+	}
+}
+
+func parseBuckets(value string) ([]float64, error) {
+	buckets := make([]float64, 0)
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		bucket, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	sort.Float64s(buckets)
+	return buckets, nil
+}
+
+func remoteHost(remote net.Addr) string {
+	if host, _, err := net.SplitHostPort(remote.String()); err == nil {
+		return host
+	}
+	return remote.String()
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativemeter.Forwarder),
+		new(kafka_nativemeter.Forwarder),
+		new(prometheusremotewrite_nativemeter.Forwarder),
+		new(otlp_nativemeter.Forwarder),
+	}
+}
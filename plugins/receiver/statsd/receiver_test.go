@@ -0,0 +1,144 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	statsdserver "github.com/apache/skywalking-satellite/plugins/server/statsd"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const testAddress = "localhost:18125"
+
+func TestReceiver_Aggregation(t *testing.T) {
+	r := startReceiver(t)
+	udp, err := net.Dial("udp", testAddress)
+	if err != nil {
+		t.Fatalf("cannot dial the statsd server: %v", err)
+	}
+	defer udp.Close()
+	tcp, err := net.Dial("tcp", testAddress)
+	if err != nil {
+		t.Fatalf("cannot dial the statsd server: %v", err)
+	}
+	defer tcp.Close()
+
+	// drain the meters flushed before sending the metrics
+	waitFlush(r)
+	packet := "api.requests:1|c|#service:svc-a,host:host-1,code:200\n" +
+		"api.requests:1|c|@0.5|#service:svc-a,host:host-1,code:200\n" +
+		"queue.size:10|g|#service:svc-a,host:host-1\n" +
+		"queue.size:-3|g|#service:svc-a,host:host-1\n" +
+		"api.latency:3:30:3000|ms|#service:svc-a,host:host-1\n" +
+		"_sc|service.check|0\n" +
+		"illegal-line\n"
+	if _, err := udp.Write([]byte(packet)); err != nil {
+		t.Fatalf("cannot send the statsd packet: %v", err)
+	}
+	if _, err := tcp.Write([]byte("users:alice|s\nusers:bob|s\nusers:alice|s\n")); err != nil {
+		t.Fatalf("cannot send the statsd lines: %v", err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// the meters may be flushed more than once, the latest meters are verified
+	meters := make(map[string]*meter.MeterData)
+	for _, e := range waitFlush(r) {
+		for _, d := range e.GetMeterCollection().GetMeterData() {
+			d.Timestamp = 0
+			meters[d.GetSingleValue().GetName()+d.GetHistogram().GetName()] = d
+		}
+	}
+	expected := map[string]*meter.MeterData{
+		"users": singleValue("statsd", "127.0.0.1", "users", 2),
+		"api_latency": {
+			Service: "svc-a", ServiceInstance: "host-1",
+			Metric: &meter.MeterData_Histogram{Histogram: &meter.MeterHistogram{Name: "api_latency", Values: []*meter.MeterBucketValue{
+				{IsNegativeInfinity: true, Count: 1}, {Bucket: 10, Count: 1}, {Bucket: 100, Count: 0}, {Bucket: 1000, Count: 1},
+			}}},
+		},
+		"api_requests": singleValue("svc-a", "host-1", "api_requests", 3, &meter.Label{Name: "code", Value: "200"}),
+		"queue_size":   singleValue("svc-a", "host-1", "queue_size", 7),
+	}
+	if diff := cmp.Diff(expected, meters, protocmp.Transform()); diff != "" {
+		t.Fatalf("the aggregated meters are not as expected: %s", diff)
+	}
+}
+
+func waitFlush(r receiver.Receiver) []*v1.SniffData {
+	events := make([]*v1.SniffData, 0)
+	timeout := time.After(1500 * time.Millisecond)
+	for {
+		select {
+		case e := <-r.Channel():
+			events = append(events, e)
+		case <-timeout:
+			return events
+		}
+	}
+}
+
+func singleValue(service, instance, name string, value float64, labels ...*meter.Label) *meter.MeterData {
+	return &meter.MeterData{
+		Service:         service,
+		ServiceInstance: instance,
+		Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+			Name:   name,
+			Labels: labels,
+			Value:  value,
+		}},
+	}
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(statsdserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: statsdserver.Name, "udp_address": testAddress, "tcp_address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the statsd server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name, "flush_interval": 1, "histogram_buckets": "1000,10,100"})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the statsd server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
@@ -0,0 +1,252 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	nilValue = "-"
+	// defaultPriority is the priority(user.notice) of the messages without the PRI part, as RFC 3164 suggests.
+	defaultPriority = 13
+	rfc3164Layout   = "Jan _2 15:04:05"
+)
+
+var (
+	facilityNames = []string{"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv",
+		"ftp", "ntp", "security", "console", "solaris-cron", "local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7"}
+	severityNames = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+	utf8BOM       = []byte{0xEF, 0xBB, 0xBF}
+)
+
+// message is a parsed syslog message of RFC 3164 or RFC 5424.
+type message struct {
+	facility       int
+	severity       int
+	timestamp      time.Time
+	hostname       string
+	appName        string
+	procID         string
+	msgID          string
+	structuredData []*sdElement
+	content        string
+}
+
+// sdElement is an element of the RFC 5424 structured data.
+type sdElement struct {
+	id     string
+	params []*sdParam
+}
+
+type sdParam struct {
+	name  string
+	value string
+}
+
+func (m *message) facilityName() string {
+	if m.facility < len(facilityNames) {
+		return facilityNames[m.facility]
+	}
+	return strconv.Itoa(m.facility)
+}
+
+func (m *message) severityName() string {
+	return severityNames[m.severity]
+}
+
+// parseMessage parses the syslog message, the format is RFC 5424 when the version follows the PRI part, otherwise RFC 3164.
+func parseMessage(data []byte, now time.Time) (*message, error) {
+	priority, rest, err := parsePriority(data)
+	if err != nil {
+		return nil, err
+	}
+	m := &message{facility: priority / 8, severity: priority % 8}
+	if len(rest) > 1 && rest[0] == '1' && rest[1] == ' ' {
+		return m, parseRFC5424(m, string(rest[2:]))
+	}
+	parseRFC3164(m, string(rest), now)
+	return m, nil
+}
+
+func parsePriority(data []byte) (priority int, rest []byte, err error) {
+	if len(data) == 0 || data[0] != '<' {
+		return defaultPriority, data, nil
+	}
+	end := bytes.IndexByte(data, '>')
+	if end < 2 || end > 4 {
+		return 0, nil, fmt.Errorf("illegal syslog priority")
+	}
+	priority, err = strconv.Atoi(string(data[1:end]))
+	if err != nil || priority < 0 || priority > 191 {
+		return 0, nil, fmt.Errorf("illegal syslog priority: %s", data[1:end])
+	}
+	return priority, data[end+1:], nil
+}
+
+// parseRFC5424 parses the header after the version: TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA [MSG].
+func parseRFC5424(m *message, data string) error {
+	fields := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		index := strings.IndexByte(data, ' ')
+		if index < 0 {
+			return fmt.Errorf("the RFC 5424 message header is incomplete")
+		}
+		fields = append(fields, data[:index])
+		data = data[index+1:]
+	}
+	if fields[0] != nilValue {
+		timestamp, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return fmt.Errorf("illegal RFC 5424 timestamp: %s", fields[0])
+		}
+		m.timestamp = timestamp
+	}
+	m.hostname = nilToEmpty(fields[1])
+	m.appName = nilToEmpty(fields[2])
+	m.procID = nilToEmpty(fields[3])
+	m.msgID = nilToEmpty(fields[4])
+
+	sd, rest, err := parseStructuredData(data)
+	if err != nil {
+		return err
+	}
+	m.structuredData = sd
+	rest = strings.TrimPrefix(rest, " ")
+	m.content = strings.TrimPrefix(rest, string(utf8BOM))
+	return nil
+}
+
+func parseStructuredData(data string) (elements []*sdElement, rest string, err error) {
+	if strings.HasPrefix(data, nilValue) {
+		return nil, data[len(nilValue):], nil
+	}
+	for len(data) > 0 && data[0] == '[' {
+		end := strings.IndexAny(data, " ]")
+		if end < 0 {
+			return nil, "", fmt.Errorf("the structured data is not closed")
+		}
+		element := &sdElement{id: data[1:end]}
+		data = data[end:]
+		for len(data) > 0 && data[0] == ' ' {
+			param, remain, err := parseSDParam(data[1:])
+			if err != nil {
+				return nil, "", err
+			}
+			element.params = append(element.params, param)
+			data = remain
+		}
+		if len(data) == 0 || data[0] != ']' {
+			return nil, "", fmt.Errorf("the structured data element %s is not closed", element.id)
+		}
+		elements = append(elements, element)
+		data = data[1:]
+	}
+	if elements == nil {
+		return nil, "", fmt.Errorf("illegal structured data")
+	}
+	return elements, data, nil
+}
+
+// parseSDParam parses the PARAM-NAME="PARAM-VALUE", the '"', '\' and ']' in the value are escaped by '\'.
+func parseSDParam(data string) (param *sdParam, rest string, err error) {
+	index := strings.Index(data, "=\"")
+	if index <= 0 {
+		return nil, "", fmt.Errorf("illegal structured data parameter")
+	}
+	param = &sdParam{name: data[:index]}
+	var value strings.Builder
+	for i := index + 2; i < len(data); i++ {
+		switch c := data[i]; c {
+		case '\\':
+			if i+1 < len(data) && (data[i+1] == '"' || data[i+1] == '\\' || data[i+1] == ']') {
+				i++
+				value.WriteByte(data[i])
+			} else {
+				value.WriteByte(c)
+			}
+		case '"':
+			param.value = value.String()
+			return param, data[i+1:], nil
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return nil, "", fmt.Errorf("the structured data parameter %s is not closed", param.name)
+}
+
+// parseRFC3164 parses the BSD syslog message: TIMESTAMP HOSTNAME TAG[PID]: MSG. The parsing is lenient as
+// the senders are various, the message which does not follow the format is kept as the content.
+func parseRFC3164(m *message, data string, now time.Time) {
+	if len(data) >= len(rfc3164Layout) {
+		if timestamp, err := time.ParseInLocation(rfc3164Layout, data[:len(rfc3164Layout)], now.Location()); err == nil {
+			m.timestamp = timestamp.AddDate(now.Year(), 0, 0)
+			// the message is sent at the end of the last year
+			if m.timestamp.After(now.AddDate(0, 1, 0)) {
+				m.timestamp = m.timestamp.AddDate(-1, 0, 0)
+			}
+			data = strings.TrimPrefix(data[len(rfc3164Layout):], " ")
+		} else if index := strings.IndexByte(data, ' '); index > 0 {
+			// some senders use the RFC 3339 timestamp
+			if timestamp, err := time.Parse(time.RFC3339Nano, data[:index]); err == nil {
+				m.timestamp = timestamp
+				data = data[index+1:]
+			}
+		}
+		if !m.timestamp.IsZero() {
+			if index := strings.IndexByte(data, ' '); index > 0 {
+				m.hostname = data[:index]
+				data = data[index+1:]
+			}
+		}
+	}
+	data = parseTag(m, data)
+	m.content = data
+}
+
+// parseTag parses the TAG[PID]: prefix of the content, return the content without the tag.
+func parseTag(m *message, data string) string {
+	index := strings.IndexByte(data, ' ')
+	if index <= 0 {
+		return data
+	}
+	tag := data[:index]
+	if !strings.HasSuffix(tag, ":") && !strings.HasSuffix(tag, "]") {
+		return data
+	}
+	tag = strings.TrimSuffix(tag, ":")
+	if start := strings.IndexByte(tag, '['); start > 0 && strings.HasSuffix(tag, "]") {
+		m.procID = tag[start+1 : len(tag)-1]
+		tag = tag[:start]
+	} else if strings.ContainsAny(tag, "[]") {
+		return data
+	}
+	m.appName = tag
+	return data[index+1:]
+}
+
+func nilToEmpty(value string) string {
+	if value == nilValue {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,114 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseMessage(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		data    string
+		want    *message
+		wantErr bool
+	}{
+		{
+			name: "rfc5424 with structured data",
+			data: `<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 ` +
+				`[exampleSDID@32473 iut="3" eventSource="Application" eventID="1011"][examplePriority@32473 class="high \"x\\y\]"]` +
+				" \xEF\xBB\xBFAn application event log entry",
+			want: &message{
+				facility:  20,
+				severity:  5,
+				timestamp: time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC),
+				hostname:  "mymachine.example.com",
+				appName:   "evntslog",
+				msgID:     "ID47",
+				structuredData: []*sdElement{
+					{id: "exampleSDID@32473", params: []*sdParam{{"iut", "3"}, {"eventSource", "Application"}, {"eventID", "1011"}}},
+					{id: "examplePriority@32473", params: []*sdParam{{"class", `high "x\y]`}}},
+				},
+				content: "An application event log entry",
+			},
+		},
+		{
+			name: "rfc5424 without structured data and message",
+			data: `<34>1 - host su 1234 - -`,
+			want: &message{facility: 4, severity: 2, hostname: "host", appName: "su", procID: "1234"},
+		},
+		{
+			name: "rfc3164",
+			data: `<34>Oct 11 22:14:15 mymachine su[230]: 'su root' failed for lonvick on /dev/pts/8`,
+			want: &message{
+				facility:  4,
+				severity:  2,
+				timestamp: time.Date(2023, 10, 11, 22, 14, 15, 0, time.UTC),
+				hostname:  "mymachine",
+				appName:   "su",
+				procID:    "230",
+				content:   "'su root' failed for lonvick on /dev/pts/8",
+			},
+		},
+		{
+			name: "rfc3164 with rfc3339 timestamp",
+			data: `<13>2024-02-28T08:00:00Z switch01 %LINK-3-UPDOWN: Interface Gi0/1, changed state to down`,
+			want: &message{
+				facility:  1,
+				severity:  5,
+				timestamp: time.Date(2024, 2, 28, 8, 0, 0, 0, time.UTC),
+				hostname:  "switch01",
+				appName:   "%LINK-3-UPDOWN",
+				content:   "Interface Gi0/1, changed state to down",
+			},
+		},
+		{
+			name: "without priority and header",
+			data: `a message without header`,
+			want: &message{facility: 1, severity: 5, content: "a message without header"},
+		},
+		{
+			name:    "illegal priority",
+			data:    `<999>1 - - - - - -`,
+			wantErr: true,
+		},
+		{
+			name:    "unclosed structured data",
+			data:    `<34>1 - host su - - [id key="value"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseMessage([]byte(tt.data), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(message{}, sdElement{}, sdParam{})); diff != "" {
+				t.Fatalf("the parsed message is not as expected: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	elasticsearch_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/elasticsearch/nativelog"
+	grpc_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativelog"
+	http_loki "github.com/apache/skywalking-satellite/plugins/forwarder/http/loki"
+	kafka_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativelog"
+	otlp_nativelog "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativelog"
+	syslog_server "github.com/apache/skywalking-satellite/plugins/server/syslog"
+
+	common "skywalking.apache.org/repo/goapi/collect/common/v3"
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "syslog-receiver"
+	ShowName  = "Syslog Receiver"
+	eventName = "syslog-event"
+
+	sourceHostname      = "hostname"
+	sourceAppName       = "app_name"
+	sourceRemoteAddress = "remote_address"
+)
+
+var severityLevels = []string{"FATAL", "FATAL", "ERROR", "ERROR", "WARN", "INFO", "INFO", "DEBUG"}
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	ServiceSource  string `mapstructure:"service_source"`  // The source of the service name, could be hostname, app_name or remote_address.
+	InstanceSource string `mapstructure:"instance_source"` // The source of the service instance name, could be hostname, app_name, remote_address or empty.
+	Layer          string `mapstructure:"layer"`           // The layer of the service, the OAP would treat it as the general layer when it is empty.
+	// components
+	Server        *syslog_server.Server
+	OutputChannel chan *v1.SniffData
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the syslog messages of RFC 3164 and RFC 5424, which converts the messages to the SkyWalking native logs. " +
+		"The facility, severity, hostname, app name, process id, message id and the structured data are kept as the log tags, " +
+		"and the service falls back to the remote address when the configured source is absent in the message."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# The source of the service name, could be hostname, app_name or remote_address.
+service_source: "hostname"
+# The source of the service instance name, could be hostname, app_name, remote_address or empty.
+instance_source: "app_name"
+# The layer of the service, the OAP would treat it as the general layer when it is empty.
+layer: ""
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*syslog_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	r.Server.RegisterHandler(r.handleMessage)
+}
+
+func (r *Receiver) RegisterSyncInvoker(_ module.SyncInvoker) {
+}
+
+func (r *Receiver) handleMessage(data []byte, remote net.Addr) {
+	m, err := parseMessage(data, time.Now())
+	if err != nil {
+		log.Logger.WithField("remote", remote.String()).Warnf("%s parse the syslog message failure: %v", r.Name(), err)
+		return
+	}
+	logData, err := proto.Marshal(r.convertToNativeLog(m, remote))
+	if err != nil {
+		log.Logger.Warnf("%s marshal the native log failure: %v", r.Name(), err)
+		return
+	}
+	r.OutputChannel <- &v1.SniffData{
+		Name:      eventName,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Meta:      event.SourcePeerMeta(remote.String()),
+		Type:      v1.SniffType_Logging,
+		Remote:    true,
+		Data: &v1.SniffData_LogList{
+			LogList: &v1.BatchLogList{
+				Logs: [][]byte{logData},
+			},
+		},
+	}
+}
+
+func (r *Receiver) convertToNativeLog(m *message, remote net.Addr) *logging.LogData {
+	service := sourceValue(r.ServiceSource, m, remote)
+	if service == "" {
+		service = sourceValue(sourceRemoteAddress, m, remote)
+	}
+	tags := []*common.KeyStringValuePair{
+		{Key: "level", Value: severityLevels[m.severity]},
+		{Key: "facility", Value: m.facilityName()},
+		{Key: "severity", Value: m.severityName()},
+	}
+	tags = appendTagIfPresent(tags, "hostname", m.hostname)
+	tags = appendTagIfPresent(tags, "app_name", m.appName)
+	tags = appendTagIfPresent(tags, "proc_id", m.procID)
+	tags = appendTagIfPresent(tags, "msg_id", m.msgID)
+	for _, element := range m.structuredData {
+		for _, param := range element.params {
+			tags = append(tags, &common.KeyStringValuePair{Key: fmt.Sprintf("%s.%s", element.id, param.name), Value: param.value})
+		}
+	}
+
+	timestamp := m.timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	return &logging.LogData{
+		Timestamp:       timestamp.UnixNano() / 1e6,
+		Service:         service,
+		ServiceInstance: sourceValue(r.InstanceSource, m, remote),
+		Body: &logging.LogDataBody{
+			Type:    "text",
+			Content: &logging.LogDataBody_Text{Text: &logging.TextLog{Text: m.content}},
+		},
+		Tags:  &logging.LogTags{Data: tags},
+		Layer: r.Layer,
+	}
+}
+
+func sourceValue(source string, m *message, remote net.Addr) string {
+	switch source {
+	case sourceHostname:
+		return m.hostname
+	case sourceAppName:
+		return m.appName
+	case sourceRemoteAddress:
+		if host, _, err := net.SplitHostPort(remote.String()); err == nil {
+			return host
+		}
+		return remote.String()
+	default:
+		return ""
+	}
+}
+
+func appendTagIfPresent(tags []*common.KeyStringValuePair, key, value string) []*common.KeyStringValuePair {
+	if value == "" {
+		return tags
+	}
+	return append(tags, &common.KeyStringValuePair{Key: key, Value: value})
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(grpc_nativelog.Forwarder),
+		new(elasticsearch_nativelog.Forwarder),
+		new(otlp_nativelog.Forwarder),
+		new(http_loki.Forwarder),
+		new(kafka_nativelog.Forwarder),
+	}
+}
@@ -0,0 +1,148 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	syslogserver "github.com/apache/skywalking-satellite/plugins/server/syslog"
+
+	logging "skywalking.apache.org/repo/goapi/collect/logging/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress    = "localhost:15140"
+	rfc5424Message = `<165>1 2003-10-11T22:14:15.003Z mymachine evntslog 1234 ID47 [origin ip="192.0.2.1"] An application event log entry`
+)
+
+func TestReceiver_UDP(t *testing.T) {
+	r := startReceiver(t)
+	conn, err := net.Dial("udp", testAddress)
+	if err != nil {
+		t.Fatalf("cannot dial the syslog server: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte(rfc5424Message + "\n")); err != nil {
+		t.Fatalf("cannot send the syslog message: %v", err)
+	}
+	logData := receiveLog(t, r)
+	if logData.GetService() != "mymachine" || logData.GetServiceInstance() != "evntslog" {
+		t.Fatalf("the service and instance should be mymachine and evntslog, but got %s and %s", logData.GetService(), logData.GetServiceInstance())
+	}
+	if logData.GetTimestamp() != time.Date(2003, 10, 11, 22, 14, 15, 3000000, time.UTC).UnixNano()/1e6 {
+		t.Fatalf("the timestamp is not as expected: %d", logData.GetTimestamp())
+	}
+	if logData.GetBody().GetText().GetText() != "An application event log entry" {
+		t.Fatalf("the log content is not as expected: %s", logData.GetBody().GetText().GetText())
+	}
+	expectedTags := map[string]string{
+		"level":     "INFO",
+		"facility":  "local4",
+		"severity":  "notice",
+		"proc_id":   "1234",
+		"msg_id":    "ID47",
+		"origin.ip": "192.0.2.1",
+	}
+	for _, tag := range logData.GetTags().GetData() {
+		if value, ok := expectedTags[tag.GetKey()]; ok && value != tag.GetValue() {
+			t.Fatalf("the tag %s should be %s, but got %s", tag.GetKey(), value, tag.GetValue())
+		}
+		delete(expectedTags, tag.GetKey())
+	}
+	if len(expectedTags) > 0 {
+		t.Fatalf("the tags are missing: %v", expectedTags)
+	}
+}
+
+func TestReceiver_TCP(t *testing.T) {
+	r := startReceiver(t)
+	conn, err := net.Dial("tcp", testAddress)
+	if err != nil {
+		t.Fatalf("cannot dial the syslog server: %v", err)
+	}
+	defer conn.Close()
+	// the first message is framed by the octet counting, and the second one is framed by the newline
+	frames := "18 <13>octet counting<34>Oct 11 22:14:15 router sshd[230]: newline framing\n"
+	if _, err := conn.Write([]byte(frames)); err != nil {
+		t.Fatalf("cannot send the syslog messages: %v", err)
+	}
+	first := receiveLog(t, r)
+	if first.GetBody().GetText().GetText() != "octet counting" {
+		t.Fatalf("the first log content is not as expected: %s", first.GetBody().GetText().GetText())
+	}
+	if first.GetService() != "127.0.0.1" {
+		t.Fatalf("the service should fall back to the remote address, but got %s", first.GetService())
+	}
+	second := receiveLog(t, r)
+	if second.GetBody().GetText().GetText() != "newline framing" || second.GetService() != "router" || second.GetServiceInstance() != "sshd" {
+		t.Fatalf("the second log is not as expected: %v", second)
+	}
+}
+
+func receiveLog(t *testing.T, r receiver.Receiver) *logging.LogData {
+	var e *v1.SniffData
+	select {
+	case e = <-r.Channel():
+	case <-time.After(5 * time.Second):
+		t.Fatalf("cannot receive the syslog event")
+	}
+	if e.GetType() != v1.SniffType_Logging || len(e.GetLogList().GetLogs()) != 1 {
+		t.Fatalf("the event should contain a log, but got %v", e)
+	}
+	logData := &logging.LogData{}
+	if err := proto.Unmarshal(e.GetLogList().GetLogs()[0], logData); err != nil {
+		t.Fatalf("cannot unmarshal the log: %v", err)
+	}
+	return logData
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(syslogserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: syslogserver.Name, "udp_address": testAddress, "tcp_address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the syslog server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the syslog server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
@@ -0,0 +1,74 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbix
+
+import (
+	"strings"
+)
+
+// itemKey is a parsed Zabbix item key, such as vfs.fs.size["/",pused].
+type itemKey struct {
+	name   string
+	params []string
+}
+
+// parseItemKey parses the item key, the quoted parameters are unquoted and the nested arrays are kept as they are.
+func parseItemKey(key string) *itemKey {
+	start := strings.IndexByte(key, '[')
+	if start < 0 || !strings.HasSuffix(key, "]") {
+		return &itemKey{name: key}
+	}
+	result := &itemKey{name: key[:start]}
+	var param strings.Builder
+	quoted, depth := false, 0
+	for i := start + 1; i < len(key)-1; i++ {
+		c := key[i]
+		switch {
+		case quoted && c == '\\' && i+1 < len(key)-1 && key[i+1] == '"':
+			param.WriteByte('"')
+			i++
+		case c == '"' && depth == 0:
+			quoted = !quoted
+		case quoted:
+			param.WriteByte(c)
+		case c == '[':
+			depth++
+			param.WriteByte(c)
+		case c == ']':
+			depth--
+			param.WriteByte(c)
+		case c == ',' && depth == 0:
+			result.params = append(result.params, strings.TrimSpace(param.String()))
+			param.Reset()
+		default:
+			param.WriteByte(c)
+		}
+	}
+	result.params = append(result.params, strings.TrimSpace(param.String()))
+	return result
+}
+
+// meterName replaces the characters which are not allowed in the meter name with the underscore.
+func meterName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
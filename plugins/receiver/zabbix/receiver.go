@@ -0,0 +1,259 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbix
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+	"github.com/apache/skywalking-satellite/internal/satellite/event"
+	module "github.com/apache/skywalking-satellite/internal/satellite/module/api"
+	forwarder "github.com/apache/skywalking-satellite/plugins/forwarder/api"
+	forwarder_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/grpc/nativemeter"
+	kafka_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/kafka/nativemeter"
+	otlp_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/otlp/nativemeter"
+	prometheusremotewrite_nativemeter "github.com/apache/skywalking-satellite/plugins/forwarder/prometheusremotewrite/nativemeter"
+	forwarder_zabbixrelay "github.com/apache/skywalking-satellite/plugins/forwarder/tcp/zabbixrelay"
+	zabbix_server "github.com/apache/skywalking-satellite/plugins/server/zabbix"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	Name      = "zabbix-receiver"
+	ShowName  = "Zabbix Receiver"
+	eventName = "zabbix-event"
+
+	activeChecksRequest = "active checks"
+	agentDataRequest    = "agent data"
+	successResponse     = "success"
+	failedResponse      = "failed"
+)
+
+type Receiver struct {
+	config.CommonFields
+	// config
+	Relay       bool     `mapstructure:"relay"`        // Relay the requests to the OAP Zabbix receiver by the sync forwarder, or convert the agent data to the native meters.
+	ActiveItems []string `mapstructure:"active_items"` // The item keys of the active checks, only works when the relay is disabled.
+	ItemDelay   int      `mapstructure:"item_delay"`   // The update interval seconds of the active check items.
+	ServiceName string   `mapstructure:"service_name"` // The service name of the meters, the agent host name is used when it is empty.
+	// components
+	Server        *zabbix_server.Server
+	OutputChannel chan *v1.SniffData
+	invoker       module.SyncInvoker
+}
+
+type agentRequest struct {
+	Request string       `json:"request"`
+	Host    string       `json:"host"`
+	Data    []*agentData `json:"data"`
+}
+
+type agentData struct {
+	Host  string `json:"host"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Clock int64  `json:"clock"`
+	NS    int64  `json:"ns"`
+	State int    `json:"state"`
+}
+
+type activeCheck struct {
+	Key         string `json:"key"`
+	Delay       int    `json:"delay"`
+	LastLogSize int64  `json:"lastlogsize"`
+	MTime       int64  `json:"mtime"`
+}
+
+type activeChecksResponse struct {
+	Response string         `json:"response"`
+	Data     []*activeCheck `json:"data"`
+}
+
+type response struct {
+	Response string `json:"response"`
+	Info     string `json:"info,omitempty"`
+}
+
+func (r *Receiver) Name() string {
+	return Name
+}
+
+func (r *Receiver) ShowName() string {
+	return ShowName
+}
+
+func (r *Receiver) Description() string {
+	return "This is a receiver for the active Zabbix agents. When the relay is enabled, the requests are relayed to the OAP Zabbix receiver " +
+		"by the sync forwarder and the responses are returned as they are. Otherwise the receiver responds the configured active check items, " +
+		"and converts the numeric agent data to the SkyWalking native meters, the meter name is the item key name with the dots replaced by " +
+		"the underscores, the item key parameters are the labels named param1, param2, and so on."
+}
+
+func (r *Receiver) DefaultConfig() string {
+	return `
+# Relay the requests to the OAP Zabbix receiver by the sync forwarder, or convert the agent data to the native meters.
+relay: false
+# The item keys of the active checks, only works when the relay is disabled.
+active_items:
+  - "system.cpu.load[all,avg1]"
+  - "vm.memory.size[available]"
+# The update interval seconds of the active check items.
+item_delay: 30
+# The service name of the meters, the agent host name is used when it is empty.
+service_name: ""
+`
+}
+
+func (r *Receiver) RegisterHandler(server interface{}) {
+	r.Server = server.(*zabbix_server.Server)
+	r.OutputChannel = make(chan *v1.SniffData)
+	if err := r.Server.RegisterHandler(r.handleRequest); err != nil {
+		log.Logger.Errorf("%s register the handler failure: %v", r.Name(), err)
+	}
+}
+
+func (r *Receiver) RegisterSyncInvoker(invoker module.SyncInvoker) {
+	r.invoker = invoker
+}
+
+func (r *Receiver) handleRequest(request []byte, _ net.Addr) ([]byte, error) {
+	if r.Relay {
+		if r.invoker == nil {
+			return nil, fmt.Errorf("the sync invoker is not registered")
+		}
+		result, _, err := r.invoker.SyncInvoke(event.NewExtensionEvent(eventName, event.ZabbixRequestType, request))
+		if err != nil {
+			return nil, err
+		}
+		return event.ExtensionData(result), nil
+	}
+	req := &agentRequest{}
+	if err := json.Unmarshal(request, req); err != nil {
+		return json.Marshal(&response{Response: failedResponse, Info: fmt.Sprintf("cannot parse the request: %v", err)})
+	}
+	switch req.Request {
+	case activeChecksRequest:
+		checks := make([]*activeCheck, 0, len(r.ActiveItems))
+		for _, key := range r.ActiveItems {
+			checks = append(checks, &activeCheck{Key: key, Delay: r.ItemDelay})
+		}
+		return json.Marshal(&activeChecksResponse{Response: successResponse, Data: checks})
+	case agentDataRequest:
+		return json.Marshal(r.handleAgentData(req))
+	default:
+		return json.Marshal(&response{Response: successResponse})
+	}
+}
+
+func (r *Receiver) handleAgentData(req *agentRequest) *response {
+	start := time.Now()
+	collections := make([]*meter.MeterDataCollection, 0)
+	groups := make(map[string]*meter.MeterDataCollection)
+	failed := 0
+	for _, data := range req.Data {
+		if data.Host == "" {
+			data.Host = req.Host
+		}
+		meterData := r.convertToMeter(data)
+		if meterData == nil {
+			failed++
+			continue
+		}
+		collection, ok := groups[data.Host]
+		if !ok {
+			collection = &meter.MeterDataCollection{}
+			groups[data.Host] = collection
+			collections = append(collections, collection)
+		}
+		collection.MeterData = append(collection.MeterData, meterData)
+	}
+	for _, collection := range collections {
+		r.OutputChannel <- &v1.SniffData{
+			Name:      eventName,
+			Timestamp: time.Now().UnixNano() / 1e6,
+			Meta:      nil,
+			Type:      v1.SniffType_MeterType,
+			Remote:    true,
+			Data: &v1.SniffData_MeterCollection{
+				MeterCollection: collection,
+			},
+		}
+	}
+	return &response{
+		Response: successResponse,
+		Info: fmt.Sprintf("processed: %d; failed: %d; total: %d; seconds spent: %f",
+			len(req.Data)-failed, failed, len(req.Data), time.Since(start).Seconds()),
+	}
+}
+
+// convertToMeter converts the numeric agent data to the meter, return nil when the item is not supported.
+func (r *Receiver) convertToMeter(data *agentData) *meter.MeterData {
+	if data.State != 0 || data.Host == "" {
+		return nil
+	}
+	value, err := strconv.ParseFloat(data.Value, 64)
+	if err != nil {
+		return nil
+	}
+	key := parseItemKey(data.Key)
+	labels := make([]*meter.Label, 0, len(key.params))
+	for i, param := range key.params {
+		if param != "" {
+			labels = append(labels, &meter.Label{Name: fmt.Sprintf("param%d", i+1), Value: param})
+		}
+	}
+	service := r.ServiceName
+	if service == "" {
+		service = data.Host
+	}
+	timestamp := time.Now().UnixNano() / 1e6
+	if data.Clock > 0 {
+		timestamp = data.Clock*1000 + data.NS/1e6
+	}
+	return &meter.MeterData{
+		Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+			Name:   meterName(key.name),
+			Labels: labels,
+			Value:  value,
+		}},
+		Service:         service,
+		ServiceInstance: data.Host,
+		Timestamp:       timestamp,
+	}
+}
+
+func (r *Receiver) Channel() <-chan *v1.SniffData {
+	return r.OutputChannel
+}
+
+func (r *Receiver) SupportForwarders() []forwarder.Forwarder {
+	return []forwarder.Forwarder{
+		new(forwarder_nativemeter.Forwarder),
+		new(kafka_nativemeter.Forwarder),
+		new(prometheusremotewrite_nativemeter.Forwarder),
+		new(otlp_nativemeter.Forwarder),
+		new(forwarder_zabbixrelay.Forwarder),
+	}
+}
@@ -0,0 +1,182 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbix
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
+	_ "github.com/apache/skywalking-satellite/internal/satellite/test"
+	"github.com/apache/skywalking-satellite/plugins/forwarder/tcp/zabbixrelay"
+	receiver "github.com/apache/skywalking-satellite/plugins/receiver/api"
+	server "github.com/apache/skywalking-satellite/plugins/server/api"
+	zabbixserver "github.com/apache/skywalking-satellite/plugins/server/zabbix"
+
+	meter "skywalking.apache.org/repo/goapi/collect/language/agent/v3"
+	v1 "skywalking.apache.org/repo/goapi/satellite/data/v1"
+)
+
+const (
+	testAddress      = "localhost:10151"
+	testRelayAddress = "localhost:10152"
+)
+
+func TestReceiver_ActiveChecks(t *testing.T) {
+	startReceiver(t)
+	resp := request(t, testAddress, `{"request":"active checks","host":"host-1"}`)
+	expected := `{"response":"success","data":[{"key":"system.cpu.load[all,avg1]","delay":30,"lastlogsize":0,"mtime":0},` +
+		`{"key":"vm.memory.size[available]","delay":30,"lastlogsize":0,"mtime":0}]}`
+	if resp != expected {
+		t.Fatalf("the response should be %s, but got %s", expected, resp)
+	}
+}
+
+func TestReceiver_AgentData(t *testing.T) {
+	r := startReceiver(t)
+	result := make(chan string)
+	go func() {
+		result <- request(t, testAddress, `{"request":"agent data","session":"s1","data":[
+			{"host":"host-1","key":"system.cpu.load[all,avg1]","value":"0.25","clock":1709280000,"ns":500000000},
+			{"host":"host-1","key":"vfs.fs.size[\"/\",pused]","value":"42","clock":1709280000,"ns":0},
+			{"host":"host-1","key":"system.uname","value":"Linux","clock":1709280000,"ns":0},
+			{"host":"host-1","key":"net.if.in[eth9]","value":"","clock":1709280000,"ns":0,"state":1}
+		]}`)
+	}()
+	e := <-r.Channel()
+	if e.GetType() != v1.SniffType_MeterType {
+		t.Fatalf("the event type should be %d, but got %d", v1.SniffType_MeterType, e.GetType())
+	}
+	expected := &meter.MeterDataCollection{MeterData: []*meter.MeterData{
+		singleValue("system_cpu_load", 0.25, 1709280000500, &meter.Label{Name: "param1", Value: "all"}, &meter.Label{Name: "param2", Value: "avg1"}),
+		singleValue("vfs_fs_size", 42, 1709280000000, &meter.Label{Name: "param1", Value: "/"}, &meter.Label{Name: "param2", Value: "pused"}),
+	}}
+	if diff := cmp.Diff(expected, e.GetMeterCollection(), protocmp.Transform()); diff != "" {
+		t.Fatalf("the converted meters are not as expected: %s", diff)
+	}
+	resp := &response{}
+	if err := json.Unmarshal([]byte(<-result), resp); err != nil {
+		t.Fatalf("cannot parse the response: %v", err)
+	}
+	if resp.Response != successResponse || !bytes.HasPrefix([]byte(resp.Info), []byte("processed: 2; failed: 2; total: 4;")) {
+		t.Fatalf("the response is not as expected: %v", resp)
+	}
+}
+
+func TestReceiver_Relay(t *testing.T) {
+	// the fake OAP Zabbix receiver
+	oap := &zabbixserver.Server{Address: testRelayAddress, MaxPacketSize: 1024, Timeout: 5}
+	if err := oap.Prepare(); err != nil {
+		t.Fatalf("cannot prepare the fake oap server: %v", err)
+	}
+	_ = oap.RegisterHandler(func(request []byte, _ net.Addr) ([]byte, error) {
+		return append([]byte(`{"response":"success","info":"relayed: `), append(request, []byte(`"}`)...)...), nil
+	})
+	if err := oap.Start(); err != nil {
+		t.Fatalf("cannot start the fake oap server: %v", err)
+	}
+	defer oap.Close()
+
+	relay := &zabbixrelay.Forwarder{ServerAddr: testRelayAddress, Timeout: 5, MaxPacketSize: 1024}
+	if err := relay.Prepare(nil); err != nil {
+		t.Fatalf("cannot prepare the relay forwarder: %v", err)
+	}
+	r := &Receiver{Relay: true}
+	r.RegisterSyncInvoker(&forwarderInvoker{relay})
+	resp, err := r.handleRequest([]byte("request"), nil)
+	if err != nil {
+		t.Fatalf("cannot relay the request: %v", err)
+	}
+	if string(resp) != `{"response":"success","info":"relayed: request"}` {
+		t.Fatalf("the relayed response is not as expected: %s", resp)
+	}
+}
+
+type forwarderInvoker struct {
+	forwarder *zabbixrelay.Forwarder
+}
+
+func (f *forwarderInvoker) SyncInvoke(d *v1.SniffData) (*v1.SniffData, grpc.ClientStream, error) {
+	return f.forwarder.SyncForward(d)
+}
+
+func singleValue(name string, value float64, timestamp int64, labels ...*meter.Label) *meter.MeterData {
+	return &meter.MeterData{
+		Metric: &meter.MeterData_SingleValue{SingleValue: &meter.MeterSingleValue{
+			Name:   name,
+			Labels: labels,
+			Value:  value,
+		}},
+		Service:         "host-1",
+		ServiceInstance: "host-1",
+		Timestamp:       timestamp,
+	}
+}
+
+func request(t *testing.T, address, data string) string {
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		t.Errorf("cannot dial the zabbix server: %v", err)
+		return ""
+	}
+	defer conn.Close()
+	if err := zabbixserver.WritePacket(conn, []byte(data)); err != nil {
+		t.Errorf("cannot write the request: %v", err)
+		return ""
+	}
+	resp, err := zabbixserver.ReadPacket(conn, 1024)
+	if err != nil {
+		t.Errorf("cannot read the response: %v", err)
+		return ""
+	}
+	return string(resp)
+}
+
+var (
+	testReceiver receiver.Receiver
+	testServer   server.Server
+)
+
+func startReceiver(t *testing.T) receiver.Receiver {
+	if testReceiver != nil {
+		return testReceiver
+	}
+	plugin.RegisterPluginCategory(reflect.TypeOf((*server.Server)(nil)).Elem())
+	plugin.RegisterPluginCategory(reflect.TypeOf((*receiver.Receiver)(nil)).Elem())
+	plugin.RegisterPlugin(new(zabbixserver.Server))
+	plugin.RegisterPlugin(new(Receiver))
+	testServer = server.GetServer(plugin.Config{plugin.NameField: zabbixserver.Name, "address": testAddress})
+	if err := testServer.Prepare(); err != nil {
+		t.Fatalf("cannot perpare the zabbix server: %v", err)
+	}
+	testReceiver = receiver.GetReceiver(plugin.Config{plugin.NameField: Name})
+	testReceiver.RegisterHandler(testServer.GetServer())
+	if err := testServer.Start(); err != nil {
+		t.Fatalf("cannot start the zabbix server: %v", err)
+	}
+	time.Sleep(time.Second)
+	return testReceiver
+}
@@ -0,0 +1,241 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+const maxPreallocSize = 1024
+
+// ExtValue is a MessagePack extension value, such as the EventTime of the Fluent Forward protocol.
+type ExtValue struct {
+	Type int8
+	Data []byte
+}
+
+// msgpackDecoder decodes the MessagePack values from the reader, the decoded values are nil, bool, int64, uint64,
+// float64, string, []byte, []interface{}, map[string]interface{} or *ExtValue. The length of the strings, binaries
+// and containers are limited by the maxSize to avoid the huge memory allocation of the illegal data.
+type msgpackDecoder struct {
+	reader  *bufio.Reader
+	maxSize int
+}
+
+func newMsgpackDecoder(reader io.Reader, maxSize int) *msgpackDecoder {
+	r, ok := reader.(*bufio.Reader)
+	if !ok {
+		r = bufio.NewReader(reader)
+	}
+	return &msgpackDecoder{reader: r, maxSize: maxSize}
+}
+
+// Decode reads the next MessagePack value.
+func (d *msgpackDecoder) Decode() (interface{}, error) {
+	code, err := d.reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case code <= 0x7f:
+		return int64(code), nil
+	case code >= 0xe0:
+		return int64(int8(code)), nil
+	case code >= 0x80 && code <= 0x8f:
+		return d.readMap(int(code & 0x0f))
+	case code >= 0x90 && code <= 0x9f:
+		return d.readArray(int(code & 0x0f))
+	case code >= 0xa0 && code <= 0xbf:
+		return d.readString(int(code & 0x1f))
+	}
+	switch code {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		length, err := d.readLength(1 << (code - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		return d.readBytes(length)
+	case 0xc7, 0xc8, 0xc9:
+		length, err := d.readLength(1 << (code - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return d.readExt(length)
+	case 0xca:
+		v, err := d.readUint(4)
+		return float64(math.Float32frombits(uint32(v))), err
+	case 0xcb:
+		v, err := d.readUint(8)
+		return math.Float64frombits(v), err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		v, err := d.readUint(1 << (code - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		if v <= math.MaxInt64 {
+			return int64(v), nil
+		}
+		return v, nil
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (code - 0xd0)
+		v, err := d.readUint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := uint(64 - size*8)
+		return int64(v<<shift) >> shift, nil
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return d.readExt(1 << (code - 0xd4))
+	case 0xd9, 0xda, 0xdb:
+		length, err := d.readLength(1 << (code - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.readString(length)
+	case 0xdc, 0xdd:
+		length, err := d.readLength(2 << (code - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.readArray(length)
+	case 0xde, 0xdf:
+		length, err := d.readLength(2 << (code - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.readMap(length)
+	default:
+		return nil, fmt.Errorf("unsupported msgpack code: 0x%x", code)
+	}
+}
+
+func (d *msgpackDecoder) readUint(size int) (uint64, error) {
+	buf := make([]byte, 8)
+	if _, err := io.ReadFull(d.reader, buf[8-size:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf), nil
+}
+
+func (d *msgpackDecoder) readLength(size int) (int, error) {
+	v, err := d.readUint(size)
+	if err != nil {
+		return 0, err
+	}
+	if v > uint64(d.maxSize) {
+		return 0, fmt.Errorf("the msgpack value length %d exceeds the max size %d", v, d.maxSize)
+	}
+	return int(v), nil
+}
+
+func (d *msgpackDecoder) readBytes(length int) ([]byte, error) {
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(d.reader, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (d *msgpackDecoder) readString(length int) (string, error) {
+	buf, err := d.readBytes(length)
+	return string(buf), err
+}
+
+func (d *msgpackDecoder) readExt(length int) (*ExtValue, error) {
+	extType, err := d.reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.readBytes(length)
+	if err != nil {
+		return nil, err
+	}
+	return &ExtValue{Type: int8(extType), Data: data}, nil
+}
+
+func (d *msgpackDecoder) readArray(length int) ([]interface{}, error) {
+	result := make([]interface{}, 0, preallocSize(length))
+	for i := 0; i < length; i++ {
+		v, err := d.Decode()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func (d *msgpackDecoder) readMap(length int) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, preallocSize(length))
+	for i := 0; i < length; i++ {
+		k, err := d.Decode()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.Decode()
+		if err != nil {
+			return nil, err
+		}
+		switch key := k.(type) {
+		case string:
+			result[key] = v
+		case []byte:
+			result[string(key)] = v
+		default:
+			result[fmt.Sprint(key)] = v
+		}
+	}
+	return result, nil
+}
+
+// preallocSize limits the preallocated capacity of the containers, the elements may be much less than the declared length.
+func preallocSize(length int) int {
+	if length > maxPreallocSize {
+		return maxPreallocSize
+	}
+	return length
+}
+
+// encodeAck encodes the ack response of the chunk, which is the {"ack": chunk} map.
+func encodeAck(chunk string) []byte {
+	buf := []byte{0x81, 0xa3, 'a', 'c', 'k'}
+	length := len(chunk)
+	switch {
+	case length < 32:
+		buf = append(buf, 0xa0|byte(length))
+	case length <= math.MaxUint8:
+		buf = append(buf, 0xd9, byte(length))
+	case length <= math.MaxUint16:
+		buf = append(buf, 0xda)
+		buf = binary.BigEndian.AppendUint16(buf, uint16(length))
+	default:
+		buf = append(buf, 0xdb)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(length))
+	}
+	return append(buf, chunk...)
+}
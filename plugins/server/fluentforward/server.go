@@ -0,0 +1,338 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fluentforward
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+const (
+	Name     = "fluent-forward-server"
+	ShowName = "Fluent Forward Server"
+
+	eventTimeExtType = 0
+	chunkOption      = "chunk"
+	compressedOption = "compressed"
+	gzipCompressed   = "gzip"
+)
+
+// Entry is an event entry of the Fluent Forward protocol.
+type Entry struct {
+	Time   time.Time
+	Record map[string]interface{}
+}
+
+// EntriesHandler handles the entries of a forward message, the ack is responded after all the handlers return.
+type EntriesHandler func(tag string, entries []*Entry, remote net.Addr)
+
+type Server struct {
+	config.CommonFields
+	Address        string `mapstructure:"address"`          // The TCP listening address.
+	TLSCertFile    string `mapstructure:"tls_cert_file"`    // The TLS cert file path.
+	TLSKeyFile     string `mapstructure:"tls_key_file"`     // The TLS key file path.
+	MaxMessageSize int    `mapstructure:"max_message_size"` // The max bytes of a msgpack value or a decompressed chunk.
+
+	handlers    []EntriesHandler
+	listener    net.Listener
+	connections sync.Map
+	closed      chan struct{}
+}
+
+func (s *Server) Name() string {
+	return Name
+}
+
+func (s *Server) ShowName() string {
+	return ShowName
+}
+
+func (s *Server) Description() string {
+	return "This is a sharing plugin, which would start a TCP server implementing the Fluent Forward protocol v1. " +
+		"The Message, Forward, PackedForward and CompressedPackedForward modes are supported, and the ack is responded when the chunk option is set. " +
+		"The shared key authentication handshake is not supported, please use the TLS to secure the connections."
+}
+
+func (s *Server) DefaultConfig() string {
+	return `
+# The TCP listening address.
+address: ":24224"
+# The TLS cert file path.
+tls_cert_file: ""
+# The TLS key file path.
+tls_key_file: ""
+# The max bytes of a msgpack value or a decompressed chunk.
+max_message_size: 16777216
+`
+}
+
+func (s *Server) Prepare() error {
+	if s.MaxMessageSize <= 0 {
+		return fmt.Errorf("the max_message_size should be positive, but got %d", s.MaxMessageSize)
+	}
+	s.closed = make(chan struct{})
+	return nil
+}
+
+// RegisterHandler registers the handler receiving all the entries, it should be called before starting the server.
+func (s *Server) RegisterHandler(handler EntriesHandler) {
+	s.handlers = append(s.handlers, handler)
+}
+
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.Address)
+	if err != nil {
+		return fmt.Errorf("cannot listen the address %s: %v", s.Address, err)
+	}
+	if s.TLSCertFile != "" && s.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(s.TLSCertFile, s.TLSKeyFile)
+		if err != nil {
+			_ = listener.Close()
+			return fmt.Errorf("error in loading the TLS files: %v", err)
+		}
+		listener = tls.NewListener(listener, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+	}
+	s.listener = listener
+	log.Logger.WithField("address", s.Address).Info("fluent forward server is starting...")
+	go s.serve()
+	return nil
+}
+
+func (s *Server) Close() error {
+	close(s.closed)
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.connections.Range(func(key, _ interface{}) bool {
+		_ = key.(net.Conn).Close()
+		return true
+	})
+	log.Logger.Info("fluent forward server is closed")
+	return nil
+}
+
+func (s *Server) GetServer() interface{} {
+	return s
+}
+
+func (s *Server) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return
+			}
+			log.Logger.WithField("address", s.Address).Warnf("fluent forward server accept the connection failure: %v", err)
+			continue
+		}
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *Server) handleConnection(conn net.Conn) {
+	s.connections.Store(conn, struct{}{})
+	defer func() {
+		s.connections.Delete(conn)
+		_ = conn.Close()
+	}()
+	decoder := newMsgpackDecoder(bufio.NewReader(conn), s.MaxMessageSize)
+	for {
+		value, err := decoder.Decode()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !s.isClosed() {
+				log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("fluent forward server read the message failure: %v", err)
+			}
+			return
+		}
+		tag, entries, option, err := s.parseMessage(value)
+		if err != nil {
+			log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("fluent forward server parse the message failure: %v", err)
+			return
+		}
+		if len(entries) > 0 {
+			for _, handler := range s.handlers {
+				handler(tag, entries, conn.RemoteAddr())
+			}
+		}
+		if chunk, ok := option[chunkOption].(string); ok && chunk != "" {
+			if _, err := conn.Write(encodeAck(chunk)); err != nil {
+				log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("fluent forward server write the ack failure: %v", err)
+				return
+			}
+		}
+	}
+}
+
+// parseMessage parses the message of the Message, Forward, PackedForward or CompressedPackedForward mode.
+func (s *Server) parseMessage(value interface{}) (tag string, entries []*Entry, option map[string]interface{}, err error) {
+	message, ok := value.([]interface{})
+	if !ok || len(message) < 2 {
+		return "", nil, nil, fmt.Errorf("the message should be an array with at least 2 elements")
+	}
+	if tag, ok = stringValue(message[0]); !ok {
+		return "", nil, nil, fmt.Errorf("the tag should be a string")
+	}
+	switch v := message[1].(type) {
+	case []interface{}:
+		option = optionAt(message, 2)
+		entries = make([]*Entry, 0, len(v))
+		for _, e := range v {
+			entry, err := parseEntry(e)
+			if err != nil {
+				return "", nil, nil, err
+			}
+			entries = append(entries, entry)
+		}
+	case string, []byte:
+		option = optionAt(message, 2)
+		entries, err = s.parsePackedEntries(v, option)
+		if err != nil {
+			return "", nil, nil, err
+		}
+	default:
+		if len(message) < 3 {
+			return "", nil, nil, fmt.Errorf("the record is missing in the message mode")
+		}
+		option = optionAt(message, 3)
+		entry, err := parseEntry([]interface{}{message[1], message[2]})
+		if err != nil {
+			return "", nil, nil, err
+		}
+		entries = []*Entry{entry}
+	}
+	return tag, entries, option, nil
+}
+
+func (s *Server) parsePackedEntries(packed interface{}, option map[string]interface{}) ([]*Entry, error) {
+	var reader io.Reader
+	if data, ok := packed.(string); ok {
+		reader = bytes.NewReader([]byte(data))
+	} else {
+		reader = bytes.NewReader(packed.([]byte))
+	}
+	if compressed, _ := option[compressedOption].(string); compressed == gzipCompressed {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the gzip entries: %v", err)
+		}
+		defer gzipReader.Close()
+		data, err := io.ReadAll(io.LimitReader(gzipReader, int64(s.MaxMessageSize)+1))
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the gzip entries: %v", err)
+		}
+		if len(data) > s.MaxMessageSize {
+			return nil, fmt.Errorf("the decompressed entries exceed the max size %d", s.MaxMessageSize)
+		}
+		reader = bytes.NewReader(data)
+	} else if compressed != "" {
+		return nil, fmt.Errorf("unsupported compression: %s", compressed)
+	}
+	decoder := newMsgpackDecoder(reader, s.MaxMessageSize)
+	entries := make([]*Entry, 0)
+	for {
+		value, err := decoder.Decode()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("cannot decode the packed entries: %v", err)
+		}
+		entry, err := parseEntry(value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func parseEntry(value interface{}) (*Entry, error) {
+	entry, ok := value.([]interface{})
+	if !ok || len(entry) < 2 {
+		return nil, fmt.Errorf("the entry should be an array of the time and the record")
+	}
+	t, err := parseTime(entry[0])
+	if err != nil {
+		return nil, err
+	}
+	record, ok := entry[1].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the record should be a map")
+	}
+	return &Entry{Time: t, Record: record}, nil
+}
+
+// parseTime parses the time of the entry, which could be the unix seconds or the EventTime extension.
+func parseTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case int64:
+		return time.Unix(v, 0), nil
+	case uint64:
+		return time.Unix(int64(v), 0), nil
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)), nil
+	case *ExtValue:
+		if v.Type != eventTimeExtType || len(v.Data) != 8 {
+			return time.Time{}, fmt.Errorf("illegal event time extension")
+		}
+		return time.Unix(int64(binary.BigEndian.Uint32(v.Data[:4])), int64(binary.BigEndian.Uint32(v.Data[4:]))), nil
+	default:
+		return time.Time{}, fmt.Errorf("illegal entry time: %v", value)
+	}
+}
+
+func optionAt(message []interface{}, index int) map[string]interface{} {
+	if len(message) > index {
+		if option, ok := message[index].(map[string]interface{}); ok {
+			return option
+		}
+	}
+	return nil
+}
+
+func stringValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case []byte:
+		return string(v), true
+	default:
+		return "", false
+	}
+}
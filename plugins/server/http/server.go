@@ -18,6 +18,7 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/apache/skywalking-satellite/internal/pkg/config"
@@ -31,8 +32,9 @@ const (
 
 type Server struct {
 	config.CommonFields
-	Address string         `mapstructure:"address"`
-	Server  *http.ServeMux // The http server.
+	Address     string         `mapstructure:"address"`
+	MaxBodySize int            `mapstructure:"max_body_size"` // The max size of a request body in MB, which is checked after decompressed.
+	Server      *http.ServeMux // The http server.
 }
 
 func (s *Server) Name() string {
@@ -51,10 +53,15 @@ func (s *Server) DefaultConfig() string {
 	return `
 # The http server address.
 address: ":12800"
+# The max size of a request body in MB, the compressed body is limited by the decompressed size.
+max_body_size: 10
 `
 }
 
 func (s *Server) Prepare() error {
+	if s.MaxBodySize <= 0 {
+		return fmt.Errorf("the max_body_size must be positive")
+	}
 	s.Server = http.NewServeMux()
 	return nil
 }
@@ -75,6 +82,11 @@ func (s *Server) Close() error {
 	return nil
 }
 
+// MaxBodyBytes returns the max size of a request body in bytes.
+func (s *Server) MaxBodyBytes() int64 {
+	return int64(s.MaxBodySize) * 1024 * 1024
+}
+
 func (s *Server) GetServer() interface{} {
 	return s
 }
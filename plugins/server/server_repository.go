@@ -22,8 +22,12 @@ import (
 
 	"github.com/apache/skywalking-satellite/internal/pkg/plugin"
 	"github.com/apache/skywalking-satellite/plugins/server/api"
+	"github.com/apache/skywalking-satellite/plugins/server/fluentforward"
 	"github.com/apache/skywalking-satellite/plugins/server/grpc"
 	"github.com/apache/skywalking-satellite/plugins/server/http"
+	"github.com/apache/skywalking-satellite/plugins/server/statsd"
+	"github.com/apache/skywalking-satellite/plugins/server/syslog"
+	"github.com/apache/skywalking-satellite/plugins/server/zabbix"
 )
 
 // RegisterServerPlugins register the used server plugins.
@@ -33,6 +37,10 @@ func RegisterServerPlugins() {
 		// Please register the server plugins at here.
 		new(grpc.Server),
 		new(http.Server),
+		new(syslog.Server),
+		new(fluentforward.Server),
+		new(statsd.Server),
+		new(zabbix.Server),
 	}
 	for _, server := range servers {
 		plugin.RegisterPlugin(server)
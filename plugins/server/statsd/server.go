@@ -0,0 +1,208 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package statsd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+const (
+	Name     = "statsd-server"
+	ShowName = "StatsD Server"
+)
+
+// LineHandler handles a StatsD line, the remote is the address of the line sender.
+type LineHandler func(line []byte, remote net.Addr)
+
+type Server struct {
+	config.CommonFields
+	UDPAddress    string `mapstructure:"udp_address"`     // The UDP listening address, the UDP listener is disabled when it is empty.
+	TCPAddress    string `mapstructure:"tcp_address"`     // The TCP listening address, the TCP listener is disabled when it is empty.
+	MaxPacketSize int    `mapstructure:"max_packet_size"` // The max bytes of a UDP packet or a TCP line.
+
+	handlers    []LineHandler
+	packetConn  net.PacketConn
+	listener    net.Listener
+	connections sync.Map
+	closed      chan struct{}
+}
+
+func (s *Server) Name() string {
+	return Name
+}
+
+func (s *Server) ShowName() string {
+	return ShowName
+}
+
+func (s *Server) Description() string {
+	return "This is a sharing plugin, which would start a StatsD server listening on UDP and TCP. " +
+		"A UDP packet could contain multiple lines, and the TCP lines are framed by the trailing newline."
+}
+
+func (s *Server) DefaultConfig() string {
+	return `
+# The UDP listening address, the UDP listener is disabled when it is empty.
+udp_address: ":8125"
+# The TCP listening address, the TCP listener is disabled when it is empty.
+tcp_address: ":8125"
+# The max bytes of a UDP packet or a TCP line.
+max_packet_size: 65536
+`
+}
+
+func (s *Server) Prepare() error {
+	if s.UDPAddress == "" && s.TCPAddress == "" {
+		return fmt.Errorf("at least one of the udp_address and the tcp_address should be set")
+	}
+	if s.MaxPacketSize <= 0 {
+		return fmt.Errorf("the max_packet_size should be positive, but got %d", s.MaxPacketSize)
+	}
+	s.closed = make(chan struct{})
+	return nil
+}
+
+// RegisterHandler registers the handler receiving all the StatsD lines, it should be called before starting the server.
+func (s *Server) RegisterHandler(handler LineHandler) {
+	s.handlers = append(s.handlers, handler)
+}
+
+func (s *Server) Start() error {
+	if s.UDPAddress != "" {
+		conn, err := net.ListenPacket("udp", s.UDPAddress)
+		if err != nil {
+			return fmt.Errorf("cannot listen the udp address %s: %v", s.UDPAddress, err)
+		}
+		s.packetConn = conn
+		log.Logger.WithField("address", s.UDPAddress).Info("statsd udp server is starting...")
+		go s.serveUDP()
+	}
+	if s.TCPAddress != "" {
+		listener, err := net.Listen("tcp", s.TCPAddress)
+		if err != nil {
+			return fmt.Errorf("cannot listen the tcp address %s: %v", s.TCPAddress, err)
+		}
+		s.listener = listener
+		log.Logger.WithField("address", s.TCPAddress).Info("statsd tcp server is starting...")
+		go s.serveTCP()
+	}
+	return nil
+}
+
+func (s *Server) Close() error {
+	close(s.closed)
+	if s.packetConn != nil {
+		_ = s.packetConn.Close()
+	}
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.connections.Range(func(key, _ interface{}) bool {
+		_ = key.(net.Conn).Close()
+		return true
+	})
+	log.Logger.Info("statsd server is closed")
+	return nil
+}
+
+func (s *Server) GetServer() interface{} {
+	return s
+}
+
+func (s *Server) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Server) serveUDP() {
+	buf := make([]byte, s.MaxPacketSize)
+	for {
+		n, remote, err := s.packetConn.ReadFrom(buf)
+		if err != nil {
+			if s.isClosed() {
+				return
+			}
+			log.Logger.WithField("address", s.UDPAddress).Warnf("statsd server read the udp packet failure: %v", err)
+			continue
+		}
+		for _, line := range bytes.Split(buf[:n], []byte{'\n'}) {
+			s.dispatch(line, remote)
+		}
+	}
+}
+
+func (s *Server) serveTCP() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return
+			}
+			log.Logger.WithField("address", s.TCPAddress).Warnf("statsd server accept the tcp connection failure: %v", err)
+			continue
+		}
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *Server) handleConnection(conn net.Conn) {
+	s.connections.Store(conn, struct{}{})
+	defer func() {
+		s.connections.Delete(conn)
+		_ = conn.Close()
+	}()
+	reader := bufio.NewReaderSize(conn, s.MaxPacketSize)
+	for {
+		line, err := reader.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("the statsd line exceeds the max packet size %d", s.MaxPacketSize)
+			return
+		}
+		s.dispatch(line, conn.RemoteAddr())
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !s.isClosed() {
+				log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("statsd server read the tcp line failure: %v", err)
+			}
+			return
+		}
+	}
+}
+
+// dispatch sends the line to the handlers, the handlers should copy the line if it is retained.
+func (s *Server) dispatch(line []byte, remote net.Addr) {
+	line = bytes.TrimRight(line, "\r\n")
+	if len(line) == 0 {
+		return
+	}
+	for _, handler := range s.handlers {
+		handler(line, remote)
+	}
+}
@@ -0,0 +1,83 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// maxFrameLengthDigits is the max digits of the octet counting length.
+const maxFrameLengthDigits = 10
+
+// readFrame reads a syslog message from the TCP stream, the message is framed by the octet counting
+// when the frame starts with a digit, otherwise it is framed by the trailing newline(RFC 6587).
+func readFrame(reader *bufio.Reader, maxSize int) ([]byte, error) {
+	first, err := reader.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if first[0] >= '0' && first[0] <= '9' {
+		return readOctetCountingFrame(reader, maxSize)
+	}
+	return readNonTransparentFrame(reader)
+}
+
+func readOctetCountingFrame(reader *bufio.Reader, maxSize int) ([]byte, error) {
+	length := 0
+	for digits := 0; ; digits++ {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == ' ' {
+			break
+		}
+		if b < '0' || b > '9' || digits >= maxFrameLengthDigits {
+			return nil, fmt.Errorf("illegal octet counting frame length")
+		}
+		length = length*10 + int(b-'0')
+	}
+	if length > maxSize {
+		return nil, fmt.Errorf("the frame length %d exceeds the max message size %d", length, maxSize)
+	}
+	message := make([]byte, length)
+	if _, err := io.ReadFull(reader, message); err != nil {
+		return nil, err
+	}
+	return trimTrailer(message), nil
+}
+
+func readNonTransparentFrame(reader *bufio.Reader) ([]byte, error) {
+	line, err := reader.ReadSlice('\n')
+	if errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("the message exceeds the max message size %d", reader.Size())
+	}
+	if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+		return nil, err
+	}
+	return append([]byte(nil), trimTrailer(line)...), nil
+}
+
+// trimTrailer removes the trailing newline and NUL characters appended by some senders.
+func trimTrailer(message []byte) []byte {
+	return bytes.TrimRight(message, "\r\n\x00")
+}
@@ -0,0 +1,217 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+const (
+	Name     = "syslog-server"
+	ShowName = "Syslog Server"
+)
+
+// MessageHandler handles a framed syslog message, the remote is the address of the message sender.
+type MessageHandler func(message []byte, remote net.Addr)
+
+type Server struct {
+	config.CommonFields
+	UDPAddress     string `mapstructure:"udp_address"`      // The UDP listening address, the UDP listener is disabled when it is empty.
+	TCPAddress     string `mapstructure:"tcp_address"`      // The TCP listening address, the TCP listener is disabled when it is empty.
+	TLSCertFile    string `mapstructure:"tls_cert_file"`    // The TLS cert file path of the TCP listener.
+	TLSKeyFile     string `mapstructure:"tls_key_file"`     // The TLS key file path of the TCP listener.
+	MaxMessageSize int    `mapstructure:"max_message_size"` // The max bytes of a syslog message.
+
+	handlers    []MessageHandler
+	packetConn  net.PacketConn
+	listener    net.Listener
+	connections sync.Map
+	closed      chan struct{}
+}
+
+func (s *Server) Name() string {
+	return Name
+}
+
+func (s *Server) ShowName() string {
+	return ShowName
+}
+
+func (s *Server) Description() string {
+	return "This is a sharing plugin, which would start a syslog server listening on UDP and TCP. " +
+		"The TCP messages could be framed by the octet counting or the trailing newline(RFC 6587), and the TLS is enabled when the cert and the key files are set."
+}
+
+func (s *Server) DefaultConfig() string {
+	return `
+# The UDP listening address, the UDP listener is disabled when it is empty.
+udp_address: ":1514"
+# The TCP listening address, the TCP listener is disabled when it is empty.
+tcp_address: ":1514"
+# The TLS cert file path of the TCP listener.
+tls_cert_file: ""
+# The TLS key file path of the TCP listener.
+tls_key_file: ""
+# The max bytes of a syslog message.
+max_message_size: 65536
+`
+}
+
+func (s *Server) Prepare() error {
+	if s.UDPAddress == "" && s.TCPAddress == "" {
+		return fmt.Errorf("at least one of the udp_address and the tcp_address should be set")
+	}
+	if s.MaxMessageSize <= 0 {
+		return fmt.Errorf("the max_message_size should be positive, but got %d", s.MaxMessageSize)
+	}
+	s.closed = make(chan struct{})
+	return nil
+}
+
+// RegisterHandler registers the handler receiving all the syslog messages, it should be called before starting the server.
+func (s *Server) RegisterHandler(handler MessageHandler) {
+	s.handlers = append(s.handlers, handler)
+}
+
+func (s *Server) Start() error {
+	if s.UDPAddress != "" {
+		conn, err := net.ListenPacket("udp", s.UDPAddress)
+		if err != nil {
+			return fmt.Errorf("cannot listen the udp address %s: %v", s.UDPAddress, err)
+		}
+		s.packetConn = conn
+		log.Logger.WithField("address", s.UDPAddress).Info("syslog udp server is starting...")
+		go s.serveUDP()
+	}
+	if s.TCPAddress != "" {
+		listener, err := net.Listen("tcp", s.TCPAddress)
+		if err != nil {
+			return fmt.Errorf("cannot listen the tcp address %s: %v", s.TCPAddress, err)
+		}
+		if s.TLSCertFile != "" && s.TLSKeyFile != "" {
+			cert, err := tls.LoadX509KeyPair(s.TLSCertFile, s.TLSKeyFile)
+			if err != nil {
+				_ = listener.Close()
+				return fmt.Errorf("error in loading the TLS files: %v", err)
+			}
+			listener = tls.NewListener(listener, &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12})
+		}
+		s.listener = listener
+		log.Logger.WithField("address", s.TCPAddress).Info("syslog tcp server is starting...")
+		go s.serveTCP()
+	}
+	return nil
+}
+
+func (s *Server) Close() error {
+	close(s.closed)
+	if s.packetConn != nil {
+		_ = s.packetConn.Close()
+	}
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.connections.Range(func(key, _ interface{}) bool {
+		_ = key.(net.Conn).Close()
+		return true
+	})
+	log.Logger.Info("syslog server is closed")
+	return nil
+}
+
+func (s *Server) GetServer() interface{} {
+	return s
+}
+
+func (s *Server) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Server) serveUDP() {
+	buf := make([]byte, s.MaxMessageSize)
+	for {
+		n, remote, err := s.packetConn.ReadFrom(buf)
+		if err != nil {
+			if s.isClosed() {
+				return
+			}
+			log.Logger.WithField("address", s.UDPAddress).Warnf("syslog server read the udp packet failure: %v", err)
+			continue
+		}
+		message := trimTrailer(buf[:n])
+		if len(message) == 0 {
+			continue
+		}
+		s.dispatch(append([]byte(nil), message...), remote)
+	}
+}
+
+func (s *Server) serveTCP() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if s.isClosed() {
+				return
+			}
+			log.Logger.WithField("address", s.TCPAddress).Warnf("syslog server accept the tcp connection failure: %v", err)
+			continue
+		}
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *Server) handleConnection(conn net.Conn) {
+	s.connections.Store(conn, struct{}{})
+	defer func() {
+		s.connections.Delete(conn)
+		_ = conn.Close()
+	}()
+	reader := bufio.NewReaderSize(conn, s.MaxMessageSize)
+	for {
+		message, err := readFrame(reader, s.MaxMessageSize)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !s.isClosed() {
+				log.Logger.WithField("remote", conn.RemoteAddr().String()).Warnf("syslog server read the tcp message failure: %v", err)
+			}
+			return
+		}
+		if len(message) > 0 {
+			s.dispatch(message, conn.RemoteAddr())
+		}
+	}
+}
+
+func (s *Server) dispatch(message []byte, remote net.Addr) {
+	for _, handler := range s.handlers {
+		handler(message, remote)
+	}
+}
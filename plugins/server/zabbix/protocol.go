@@ -0,0 +1,95 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbix
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The Zabbix protocol packet is "ZBXD" + flags(1 byte) + data length + reserved + data. The length and the reserved
+// fields are 4 bytes little endian, or 8 bytes when the large packet flag is set. The reserved field is the
+// uncompressed data length when the data is compressed by zlib.
+const (
+	flagProtocol   = 0x01
+	flagCompressed = 0x02
+	flagLarge      = 0x04
+)
+
+var protocolHeader = []byte("ZBXD")
+
+// ReadPacket reads a Zabbix protocol packet and returns the uncompressed data.
+func ReadPacket(reader io.Reader, maxSize int) ([]byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:4], protocolHeader) || header[4]&flagProtocol == 0 {
+		return nil, fmt.Errorf("illegal zabbix protocol header")
+	}
+	flags := header[4]
+	var length, reserved uint64
+	if flags&flagLarge != 0 {
+		sizes := make([]byte, 16)
+		if _, err := io.ReadFull(reader, sizes); err != nil {
+			return nil, err
+		}
+		length, reserved = binary.LittleEndian.Uint64(sizes[:8]), binary.LittleEndian.Uint64(sizes[8:])
+	} else {
+		sizes := make([]byte, 8)
+		if _, err := io.ReadFull(reader, sizes); err != nil {
+			return nil, err
+		}
+		length, reserved = uint64(binary.LittleEndian.Uint32(sizes[:4])), uint64(binary.LittleEndian.Uint32(sizes[4:]))
+	}
+	if length > uint64(maxSize) || (flags&flagCompressed != 0 && reserved > uint64(maxSize)) {
+		return nil, fmt.Errorf("the zabbix packet exceeds the max size %d", maxSize)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+	if flags&flagCompressed == 0 {
+		return data, nil
+	}
+	zlibReader, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the compressed zabbix packet: %v", err)
+	}
+	defer zlibReader.Close()
+	uncompressed := make([]byte, reserved)
+	if _, err := io.ReadFull(zlibReader, uncompressed); err != nil {
+		return nil, fmt.Errorf("cannot read the compressed zabbix packet: %v", err)
+	}
+	return uncompressed, nil
+}
+
+// WritePacket writes the data as an uncompressed Zabbix protocol packet.
+func WritePacket(writer io.Writer, data []byte) error {
+	packet := make([]byte, 0, len(data)+13)
+	packet = append(packet, protocolHeader...)
+	packet = append(packet, flagProtocol)
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(len(data))) // #nosec G115
+	packet = binary.LittleEndian.AppendUint32(packet, 0)
+	packet = append(packet, data...)
+	_, err := writer.Write(packet)
+	return err
+}
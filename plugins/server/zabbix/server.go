@@ -0,0 +1,160 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package zabbix
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/apache/skywalking-satellite/internal/pkg/config"
+	"github.com/apache/skywalking-satellite/internal/pkg/log"
+)
+
+const (
+	Name     = "zabbix-server"
+	ShowName = "Zabbix Server"
+)
+
+// RequestHandler handles the JSON of the Zabbix agent request and returns the JSON of the response.
+type RequestHandler func(request []byte, remote net.Addr) ([]byte, error)
+
+type Server struct {
+	config.CommonFields
+	Address       string `mapstructure:"address"`         // The TCP listening address.
+	MaxPacketSize int    `mapstructure:"max_packet_size"` // The max bytes of an uncompressed Zabbix packet.
+	Timeout       int    `mapstructure:"timeout"`         // The read and write timeout seconds of a connection.
+
+	handler     RequestHandler
+	listener    net.Listener
+	connections sync.Map
+	closed      chan struct{}
+}
+
+func (s *Server) Name() string {
+	return Name
+}
+
+func (s *Server) ShowName() string {
+	return ShowName
+}
+
+func (s *Server) Description() string {
+	return "This is a sharing plugin, which would start a TCP server speaking the Zabbix protocol to the active Zabbix agents. " +
+		"Every connection handles a request and is closed after the response, as the Zabbix server does."
+}
+
+func (s *Server) DefaultConfig() string {
+	return `
+# The TCP listening address.
+address: ":10051"
+# The max bytes of an uncompressed Zabbix packet.
+max_packet_size: 16777216
+# The read and write timeout seconds of a connection.
+timeout: 10
+`
+}
+
+func (s *Server) Prepare() error {
+	if s.MaxPacketSize <= 0 {
+		return fmt.Errorf("the max_packet_size should be positive, but got %d", s.MaxPacketSize)
+	}
+	s.closed = make(chan struct{})
+	return nil
+}
+
+// RegisterHandler registers the handler of the Zabbix requests, only one handler is allowed as the request needs a response.
+func (s *Server) RegisterHandler(handler RequestHandler) error {
+	if s.handler != nil {
+		return fmt.Errorf("the %s only supports one receiver", s.Name())
+	}
+	s.handler = handler
+	return nil
+}
+
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.Address)
+	if err != nil {
+		return fmt.Errorf("cannot listen the address %s: %v", s.Address, err)
+	}
+	s.listener = listener
+	log.Logger.WithField("address", s.Address).Info("zabbix server is starting...")
+	go s.serve()
+	return nil
+}
+
+func (s *Server) Close() error {
+	close(s.closed)
+	if s.listener != nil {
+		_ = s.listener.Close()
+	}
+	s.connections.Range(func(key, _ interface{}) bool {
+		_ = key.(net.Conn).Close()
+		return true
+	})
+	log.Logger.Info("zabbix server is closed")
+	return nil
+}
+
+func (s *Server) GetServer() interface{} {
+	return s
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			select {
+			case <-s.closed:
+				return
+			default:
+			}
+			log.Logger.WithField("address", s.Address).Warnf("zabbix server accept the connection failure: %v", err)
+			continue
+		}
+		go s.handleConnection(conn)
+	}
+}
+
+func (s *Server) handleConnection(conn net.Conn) {
+	s.connections.Store(conn, struct{}{})
+	defer func() {
+		s.connections.Delete(conn)
+		_ = conn.Close()
+	}()
+	logger := log.Logger.WithField("remote", conn.RemoteAddr().String())
+	_ = conn.SetDeadline(time.Now().Add(time.Duration(s.Timeout) * time.Second))
+	request, err := ReadPacket(conn, s.MaxPacketSize)
+	if err != nil {
+		logger.Warnf("zabbix server read the request failure: %v", err)
+		return
+	}
+	if s.handler == nil {
+		logger.Warnf("zabbix server drops the request as no receiver is registered")
+		return
+	}
+	response, err := s.handler(request, conn.RemoteAddr())
+	if err != nil {
+		logger.Warnf("zabbix server handle the request failure: %v", err)
+		return
+	}
+	if err := WritePacket(conn, response); err != nil {
+		logger.Warnf("zabbix server write the response failure: %v", err)
+	}
+}
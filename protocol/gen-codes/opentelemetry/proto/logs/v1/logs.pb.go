@@ -0,0 +1,596 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: opentelemetry/proto/logs/v1/logs.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	v11 "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/common/v1"
+	v1 "skywalking.apache.org/repo/goapi/proto/opentelemetry/proto/resource/v1"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SeverityNumber int32
+
+const (
+	SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED SeverityNumber = 0
+	SeverityNumber_SEVERITY_NUMBER_TRACE       SeverityNumber = 1
+	SeverityNumber_SEVERITY_NUMBER_TRACE2      SeverityNumber = 2
+	SeverityNumber_SEVERITY_NUMBER_TRACE3      SeverityNumber = 3
+	SeverityNumber_SEVERITY_NUMBER_TRACE4      SeverityNumber = 4
+	SeverityNumber_SEVERITY_NUMBER_DEBUG       SeverityNumber = 5
+	SeverityNumber_SEVERITY_NUMBER_DEBUG2      SeverityNumber = 6
+	SeverityNumber_SEVERITY_NUMBER_DEBUG3      SeverityNumber = 7
+	SeverityNumber_SEVERITY_NUMBER_DEBUG4      SeverityNumber = 8
+	SeverityNumber_SEVERITY_NUMBER_INFO        SeverityNumber = 9
+	SeverityNumber_SEVERITY_NUMBER_INFO2       SeverityNumber = 10
+	SeverityNumber_SEVERITY_NUMBER_INFO3       SeverityNumber = 11
+	SeverityNumber_SEVERITY_NUMBER_INFO4       SeverityNumber = 12
+	SeverityNumber_SEVERITY_NUMBER_WARN        SeverityNumber = 13
+	SeverityNumber_SEVERITY_NUMBER_WARN2       SeverityNumber = 14
+	SeverityNumber_SEVERITY_NUMBER_WARN3       SeverityNumber = 15
+	SeverityNumber_SEVERITY_NUMBER_WARN4       SeverityNumber = 16
+	SeverityNumber_SEVERITY_NUMBER_ERROR       SeverityNumber = 17
+	SeverityNumber_SEVERITY_NUMBER_ERROR2      SeverityNumber = 18
+	SeverityNumber_SEVERITY_NUMBER_ERROR3      SeverityNumber = 19
+	SeverityNumber_SEVERITY_NUMBER_ERROR4      SeverityNumber = 20
+	SeverityNumber_SEVERITY_NUMBER_FATAL       SeverityNumber = 21
+	SeverityNumber_SEVERITY_NUMBER_FATAL2      SeverityNumber = 22
+	SeverityNumber_SEVERITY_NUMBER_FATAL3      SeverityNumber = 23
+	SeverityNumber_SEVERITY_NUMBER_FATAL4      SeverityNumber = 24
+)
+
+// Enum value maps for SeverityNumber.
+var (
+	SeverityNumber_name = map[int32]string{
+		0:  "SEVERITY_NUMBER_UNSPECIFIED",
+		1:  "SEVERITY_NUMBER_TRACE",
+		2:  "SEVERITY_NUMBER_TRACE2",
+		3:  "SEVERITY_NUMBER_TRACE3",
+		4:  "SEVERITY_NUMBER_TRACE4",
+		5:  "SEVERITY_NUMBER_DEBUG",
+		6:  "SEVERITY_NUMBER_DEBUG2",
+		7:  "SEVERITY_NUMBER_DEBUG3",
+		8:  "SEVERITY_NUMBER_DEBUG4",
+		9:  "SEVERITY_NUMBER_INFO",
+		10: "SEVERITY_NUMBER_INFO2",
+		11: "SEVERITY_NUMBER_INFO3",
+		12: "SEVERITY_NUMBER_INFO4",
+		13: "SEVERITY_NUMBER_WARN",
+		14: "SEVERITY_NUMBER_WARN2",
+		15: "SEVERITY_NUMBER_WARN3",
+		16: "SEVERITY_NUMBER_WARN4",
+		17: "SEVERITY_NUMBER_ERROR",
+		18: "SEVERITY_NUMBER_ERROR2",
+		19: "SEVERITY_NUMBER_ERROR3",
+		20: "SEVERITY_NUMBER_ERROR4",
+		21: "SEVERITY_NUMBER_FATAL",
+		22: "SEVERITY_NUMBER_FATAL2",
+		23: "SEVERITY_NUMBER_FATAL3",
+		24: "SEVERITY_NUMBER_FATAL4",
+	}
+	SeverityNumber_value = map[string]int32{
+		"SEVERITY_NUMBER_UNSPECIFIED": 0,
+		"SEVERITY_NUMBER_TRACE":       1,
+		"SEVERITY_NUMBER_TRACE2":      2,
+		"SEVERITY_NUMBER_TRACE3":      3,
+		"SEVERITY_NUMBER_TRACE4":      4,
+		"SEVERITY_NUMBER_DEBUG":       5,
+		"SEVERITY_NUMBER_DEBUG2":      6,
+		"SEVERITY_NUMBER_DEBUG3":      7,
+		"SEVERITY_NUMBER_DEBUG4":      8,
+		"SEVERITY_NUMBER_INFO":        9,
+		"SEVERITY_NUMBER_INFO2":       10,
+		"SEVERITY_NUMBER_INFO3":       11,
+		"SEVERITY_NUMBER_INFO4":       12,
+		"SEVERITY_NUMBER_WARN":        13,
+		"SEVERITY_NUMBER_WARN2":       14,
+		"SEVERITY_NUMBER_WARN3":       15,
+		"SEVERITY_NUMBER_WARN4":       16,
+		"SEVERITY_NUMBER_ERROR":       17,
+		"SEVERITY_NUMBER_ERROR2":      18,
+		"SEVERITY_NUMBER_ERROR3":      19,
+		"SEVERITY_NUMBER_ERROR4":      20,
+		"SEVERITY_NUMBER_FATAL":       21,
+		"SEVERITY_NUMBER_FATAL2":      22,
+		"SEVERITY_NUMBER_FATAL3":      23,
+		"SEVERITY_NUMBER_FATAL4":      24,
+	}
+)
+
+func (x SeverityNumber) Enum() *SeverityNumber {
+	p := new(SeverityNumber)
+	*p = x
+	return p
+}
+
+func (x SeverityNumber) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeverityNumber) Descriptor() protoreflect.EnumDescriptor {
+	return file_opentelemetry_proto_logs_v1_logs_proto_enumTypes[0].Descriptor()
+}
+
+func (SeverityNumber) Type() protoreflect.EnumType {
+	return &file_opentelemetry_proto_logs_v1_logs_proto_enumTypes[0]
+}
+
+func (x SeverityNumber) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeverityNumber.Descriptor instead.
+func (SeverityNumber) EnumDescriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{0}
+}
+
+type LogRecordFlags int32
+
+const (
+	LogRecordFlags_LOG_RECORD_FLAGS_DO_NOT_USE       LogRecordFlags = 0
+	LogRecordFlags_LOG_RECORD_FLAGS_TRACE_FLAGS_MASK LogRecordFlags = 255
+)
+
+// Enum value maps for LogRecordFlags.
+var (
+	LogRecordFlags_name = map[int32]string{
+		0:   "LOG_RECORD_FLAGS_DO_NOT_USE",
+		255: "LOG_RECORD_FLAGS_TRACE_FLAGS_MASK",
+	}
+	LogRecordFlags_value = map[string]int32{
+		"LOG_RECORD_FLAGS_DO_NOT_USE":       0,
+		"LOG_RECORD_FLAGS_TRACE_FLAGS_MASK": 255,
+	}
+)
+
+func (x LogRecordFlags) Enum() *LogRecordFlags {
+	p := new(LogRecordFlags)
+	*p = x
+	return p
+}
+
+func (x LogRecordFlags) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogRecordFlags) Descriptor() protoreflect.EnumDescriptor {
+	return file_opentelemetry_proto_logs_v1_logs_proto_enumTypes[1].Descriptor()
+}
+
+func (LogRecordFlags) Type() protoreflect.EnumType {
+	return &file_opentelemetry_proto_logs_v1_logs_proto_enumTypes[1]
+}
+
+func (x LogRecordFlags) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogRecordFlags.Descriptor instead.
+func (LogRecordFlags) EnumDescriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{1}
+}
+
+type LogsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceLogs  []*ResourceLogs        `protobuf:"bytes,1,rep,name=resource_logs,json=resourceLogs,proto3" json:"resource_logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogsData) Reset() {
+	*x = LogsData{}
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsData) ProtoMessage() {}
+
+func (x *LogsData) ProtoReflect() protoreflect.Message {
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsData.ProtoReflect.Descriptor instead.
+func (*LogsData) Descriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LogsData) GetResourceLogs() []*ResourceLogs {
+	if x != nil {
+		return x.ResourceLogs
+	}
+	return nil
+}
+
+type ResourceLogs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *v1.Resource           `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	ScopeLogs     []*ScopeLogs           `protobuf:"bytes,2,rep,name=scope_logs,json=scopeLogs,proto3" json:"scope_logs,omitempty"`
+	SchemaUrl     string                 `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceLogs) Reset() {
+	*x = ResourceLogs{}
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLogs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLogs) ProtoMessage() {}
+
+func (x *ResourceLogs) ProtoReflect() protoreflect.Message {
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLogs.ProtoReflect.Descriptor instead.
+func (*ResourceLogs) Descriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceLogs) GetResource() *v1.Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *ResourceLogs) GetScopeLogs() []*ScopeLogs {
+	if x != nil {
+		return x.ScopeLogs
+	}
+	return nil
+}
+
+func (x *ResourceLogs) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+type ScopeLogs struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Scope         *v11.InstrumentationScope `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	LogRecords    []*LogRecord              `protobuf:"bytes,2,rep,name=log_records,json=logRecords,proto3" json:"log_records,omitempty"`
+	SchemaUrl     string                    `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScopeLogs) Reset() {
+	*x = ScopeLogs{}
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScopeLogs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScopeLogs) ProtoMessage() {}
+
+func (x *ScopeLogs) ProtoReflect() protoreflect.Message {
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScopeLogs.ProtoReflect.Descriptor instead.
+func (*ScopeLogs) Descriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScopeLogs) GetScope() *v11.InstrumentationScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ScopeLogs) GetLogRecords() []*LogRecord {
+	if x != nil {
+		return x.LogRecords
+	}
+	return nil
+}
+
+func (x *ScopeLogs) GetSchemaUrl() string {
+	if x != nil {
+		return x.SchemaUrl
+	}
+	return ""
+}
+
+type LogRecord struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TimeUnixNano           uint64                 `protobuf:"fixed64,1,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	ObservedTimeUnixNano   uint64                 `protobuf:"fixed64,11,opt,name=observed_time_unix_nano,json=observedTimeUnixNano,proto3" json:"observed_time_unix_nano,omitempty"`
+	SeverityNumber         SeverityNumber         `protobuf:"varint,2,opt,name=severity_number,json=severityNumber,proto3,enum=opentelemetry.proto.logs.v1.SeverityNumber" json:"severity_number,omitempty"`
+	SeverityText           string                 `protobuf:"bytes,3,opt,name=severity_text,json=severityText,proto3" json:"severity_text,omitempty"`
+	Body                   *v11.AnyValue          `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Attributes             []*v11.KeyValue        `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	DroppedAttributesCount uint32                 `protobuf:"varint,7,opt,name=dropped_attributes_count,json=droppedAttributesCount,proto3" json:"dropped_attributes_count,omitempty"`
+	Flags                  uint32                 `protobuf:"fixed32,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	TraceId                []byte                 `protobuf:"bytes,9,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	SpanId                 []byte                 `protobuf:"bytes,10,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LogRecord) Reset() {
+	*x = LogRecord{}
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRecord) ProtoMessage() {}
+
+func (x *LogRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_opentelemetry_proto_logs_v1_logs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRecord.ProtoReflect.Descriptor instead.
+func (*LogRecord) Descriptor() ([]byte, []int) {
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogRecord) GetTimeUnixNano() uint64 {
+	if x != nil {
+		return x.TimeUnixNano
+	}
+	return 0
+}
+
+func (x *LogRecord) GetObservedTimeUnixNano() uint64 {
+	if x != nil {
+		return x.ObservedTimeUnixNano
+	}
+	return 0
+}
+
+func (x *LogRecord) GetSeverityNumber() SeverityNumber {
+	if x != nil {
+		return x.SeverityNumber
+	}
+	return SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED
+}
+
+func (x *LogRecord) GetSeverityText() string {
+	if x != nil {
+		return x.SeverityText
+	}
+	return ""
+}
+
+func (x *LogRecord) GetBody() *v11.AnyValue {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *LogRecord) GetAttributes() []*v11.KeyValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *LogRecord) GetDroppedAttributesCount() uint32 {
+	if x != nil {
+		return x.DroppedAttributesCount
+	}
+	return 0
+}
+
+func (x *LogRecord) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LogRecord) GetTraceId() []byte {
+	if x != nil {
+		return x.TraceId
+	}
+	return nil
+}
+
+func (x *LogRecord) GetSpanId() []byte {
+	if x != nil {
+		return x.SpanId
+	}
+	return nil
+}
+
+var File_opentelemetry_proto_logs_v1_logs_proto protoreflect.FileDescriptor
+
+const file_opentelemetry_proto_logs_v1_logs_proto_rawDesc = "" +
+	"\n" +
+	"&opentelemetry/proto/logs/v1/logs.proto\x12\x1bopentelemetry.proto.logs.v1\x1a*opentelemetry/proto/common/v1/common.proto\x1a.opentelemetry/proto/resource/v1/resource.proto\"Z\n" +
+	"\bLogsData\x12N\n" +
+	"\rresource_logs\x18\x01 \x03(\v2).opentelemetry.proto.logs.v1.ResourceLogsR\fresourceLogs\"\xbb\x01\n" +
+	"\fResourceLogs\x12E\n" +
+	"\bresource\x18\x01 \x01(\v2).opentelemetry.proto.resource.v1.ResourceR\bresource\x12E\n" +
+	"\n" +
+	"scope_logs\x18\x02 \x03(\v2&.opentelemetry.proto.logs.v1.ScopeLogsR\tscopeLogs\x12\x1d\n" +
+	"\n" +
+	"schema_url\x18\x03 \x01(\tR\tschemaUrl\"\xbe\x01\n" +
+	"\tScopeLogs\x12I\n" +
+	"\x05scope\x18\x01 \x01(\v23.opentelemetry.proto.common.v1.InstrumentationScopeR\x05scope\x12G\n" +
+	"\vlog_records\x18\x02 \x03(\v2&.opentelemetry.proto.logs.v1.LogRecordR\n" +
+	"logRecords\x12\x1d\n" +
+	"\n" +
+	"schema_url\x18\x03 \x01(\tR\tschemaUrl\"\xed\x03\n" +
+	"\tLogRecord\x12$\n" +
+	"\x0etime_unix_nano\x18\x01 \x01(\x06R\ftimeUnixNano\x125\n" +
+	"\x17observed_time_unix_nano\x18\v \x01(\x06R\x14observedTimeUnixNano\x12T\n" +
+	"\x0fseverity_number\x18\x02 \x01(\x0e2+.opentelemetry.proto.logs.v1.SeverityNumberR\x0eseverityNumber\x12#\n" +
+	"\rseverity_text\x18\x03 \x01(\tR\fseverityText\x12;\n" +
+	"\x04body\x18\x05 \x01(\v2'.opentelemetry.proto.common.v1.AnyValueR\x04body\x12G\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2'.opentelemetry.proto.common.v1.KeyValueR\n" +
+	"attributes\x128\n" +
+	"\x18dropped_attributes_count\x18\a \x01(\rR\x16droppedAttributesCount\x12\x14\n" +
+	"\x05flags\x18\b \x01(\aR\x05flags\x12\x19\n" +
+	"\btrace_id\x18\t \x01(\fR\atraceId\x12\x17\n" +
+	"\aspan_id\x18\n" +
+	" \x01(\fR\x06spanId*\xc3\x05\n" +
+	"\x0eSeverityNumber\x12\x1f\n" +
+	"\x1bSEVERITY_NUMBER_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_TRACE\x10\x01\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_TRACE2\x10\x02\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_TRACE3\x10\x03\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_TRACE4\x10\x04\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_DEBUG\x10\x05\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_DEBUG2\x10\x06\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_DEBUG3\x10\a\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_DEBUG4\x10\b\x12\x18\n" +
+	"\x14SEVERITY_NUMBER_INFO\x10\t\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_INFO2\x10\n" +
+	"\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_INFO3\x10\v\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_INFO4\x10\f\x12\x18\n" +
+	"\x14SEVERITY_NUMBER_WARN\x10\r\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_WARN2\x10\x0e\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_WARN3\x10\x0f\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_WARN4\x10\x10\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_ERROR\x10\x11\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_ERROR2\x10\x12\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_ERROR3\x10\x13\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_ERROR4\x10\x14\x12\x19\n" +
+	"\x15SEVERITY_NUMBER_FATAL\x10\x15\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_FATAL2\x10\x16\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_FATAL3\x10\x17\x12\x1a\n" +
+	"\x16SEVERITY_NUMBER_FATAL4\x10\x18*Y\n" +
+	"\x0eLogRecordFlags\x12\x1f\n" +
+	"\x1bLOG_RECORD_FLAGS_DO_NOT_USE\x10\x00\x12&\n" +
+	"!LOG_RECORD_FLAGS_TRACE_FLAGS_MASK\x10\xff\x01BZZXgithub.com/apache/skywalking-satellite/protocol/gen-codes/opentelemetry/proto/logs/v1;v1b\x06proto3"
+
+var (
+	file_opentelemetry_proto_logs_v1_logs_proto_rawDescOnce sync.Once
+	file_opentelemetry_proto_logs_v1_logs_proto_rawDescData []byte
+)
+
+func file_opentelemetry_proto_logs_v1_logs_proto_rawDescGZIP() []byte {
+	file_opentelemetry_proto_logs_v1_logs_proto_rawDescOnce.Do(func() {
+		file_opentelemetry_proto_logs_v1_logs_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_opentelemetry_proto_logs_v1_logs_proto_rawDesc), len(file_opentelemetry_proto_logs_v1_logs_proto_rawDesc)))
+	})
+	return file_opentelemetry_proto_logs_v1_logs_proto_rawDescData
+}
+
+var file_opentelemetry_proto_logs_v1_logs_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_opentelemetry_proto_logs_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_opentelemetry_proto_logs_v1_logs_proto_goTypes = []any{
+	(SeverityNumber)(0),              // 0: opentelemetry.proto.logs.v1.SeverityNumber
+	(LogRecordFlags)(0),              // 1: opentelemetry.proto.logs.v1.LogRecordFlags
+	(*LogsData)(nil),                 // 2: opentelemetry.proto.logs.v1.LogsData
+	(*ResourceLogs)(nil),             // 3: opentelemetry.proto.logs.v1.ResourceLogs
+	(*ScopeLogs)(nil),                // 4: opentelemetry.proto.logs.v1.ScopeLogs
+	(*LogRecord)(nil),                // 5: opentelemetry.proto.logs.v1.LogRecord
+	(*v1.Resource)(nil),              // 6: opentelemetry.proto.resource.v1.Resource
+	(*v11.InstrumentationScope)(nil), // 7: opentelemetry.proto.common.v1.InstrumentationScope
+	(*v11.AnyValue)(nil),             // 8: opentelemetry.proto.common.v1.AnyValue
+	(*v11.KeyValue)(nil),             // 9: opentelemetry.proto.common.v1.KeyValue
+}
+var file_opentelemetry_proto_logs_v1_logs_proto_depIdxs = []int32{
+	3, // 0: opentelemetry.proto.logs.v1.LogsData.resource_logs:type_name -> opentelemetry.proto.logs.v1.ResourceLogs
+	6, // 1: opentelemetry.proto.logs.v1.ResourceLogs.resource:type_name -> opentelemetry.proto.resource.v1.Resource
+	4, // 2: opentelemetry.proto.logs.v1.ResourceLogs.scope_logs:type_name -> opentelemetry.proto.logs.v1.ScopeLogs
+	7, // 3: opentelemetry.proto.logs.v1.ScopeLogs.scope:type_name -> opentelemetry.proto.common.v1.InstrumentationScope
+	5, // 4: opentelemetry.proto.logs.v1.ScopeLogs.log_records:type_name -> opentelemetry.proto.logs.v1.LogRecord
+	0, // 5: opentelemetry.proto.logs.v1.LogRecord.severity_number:type_name -> opentelemetry.proto.logs.v1.SeverityNumber
+	8, // 6: opentelemetry.proto.logs.v1.LogRecord.body:type_name -> opentelemetry.proto.common.v1.AnyValue
+	9, // 7: opentelemetry.proto.logs.v1.LogRecord.attributes:type_name -> opentelemetry.proto.common.v1.KeyValue
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_opentelemetry_proto_logs_v1_logs_proto_init() }
+func file_opentelemetry_proto_logs_v1_logs_proto_init() {
+	if File_opentelemetry_proto_logs_v1_logs_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_opentelemetry_proto_logs_v1_logs_proto_rawDesc), len(file_opentelemetry_proto_logs_v1_logs_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_opentelemetry_proto_logs_v1_logs_proto_goTypes,
+		DependencyIndexes: file_opentelemetry_proto_logs_v1_logs_proto_depIdxs,
+		EnumInfos:         file_opentelemetry_proto_logs_v1_logs_proto_enumTypes,
+		MessageInfos:      file_opentelemetry_proto_logs_v1_logs_proto_msgTypes,
+	}.Build()
+	File_opentelemetry_proto_logs_v1_logs_proto = out.File
+	file_opentelemetry_proto_logs_v1_logs_proto_goTypes = nil
+	file_opentelemetry_proto_logs_v1_logs_proto_depIdxs = nil
+}
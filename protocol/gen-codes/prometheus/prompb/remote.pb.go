@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: prometheus/prompb/remote.proto
+
+package prompb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timeseries    []*TimeSeries          `protobuf:"bytes,1,rep,name=timeseries,proto3" json:"timeseries,omitempty"`
+	Metadata      []*MetricMetadata      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	mi := &file_prometheus_prompb_remote_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prometheus_prompb_remote_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_prometheus_prompb_remote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WriteRequest) GetTimeseries() []*TimeSeries {
+	if x != nil {
+		return x.Timeseries
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetMetadata() []*MetricMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_prometheus_prompb_remote_proto protoreflect.FileDescriptor
+
+const file_prometheus_prompb_remote_proto_rawDesc = "" +
+	"\n" +
+	"\x1eprometheus/prompb/remote.proto\x12\n" +
+	"prometheus\x1a\x1dprometheus/prompb/types.proto\"~\n" +
+	"\fWriteRequest\x126\n" +
+	"\n" +
+	"timeseries\x18\x01 \x03(\v2\x16.prometheus.TimeSeriesR\n" +
+	"timeseries\x126\n" +
+	"\bmetadata\x18\x03 \x03(\v2\x1a.prometheus.MetricMetadataR\bmetadataBTZRgithub.com/apache/skywalking-satellite/protocol/gen-codes/prometheus/prompb;prompbb\x06proto3"
+
+var (
+	file_prometheus_prompb_remote_proto_rawDescOnce sync.Once
+	file_prometheus_prompb_remote_proto_rawDescData []byte
+)
+
+func file_prometheus_prompb_remote_proto_rawDescGZIP() []byte {
+	file_prometheus_prompb_remote_proto_rawDescOnce.Do(func() {
+		file_prometheus_prompb_remote_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prometheus_prompb_remote_proto_rawDesc), len(file_prometheus_prompb_remote_proto_rawDesc)))
+	})
+	return file_prometheus_prompb_remote_proto_rawDescData
+}
+
+var file_prometheus_prompb_remote_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_prometheus_prompb_remote_proto_goTypes = []any{
+	(*WriteRequest)(nil),   // 0: prometheus.WriteRequest
+	(*TimeSeries)(nil),     // 1: prometheus.TimeSeries
+	(*MetricMetadata)(nil), // 2: prometheus.MetricMetadata
+}
+var file_prometheus_prompb_remote_proto_depIdxs = []int32{
+	1, // 0: prometheus.WriteRequest.timeseries:type_name -> prometheus.TimeSeries
+	2, // 1: prometheus.WriteRequest.metadata:type_name -> prometheus.MetricMetadata
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_prometheus_prompb_remote_proto_init() }
+func file_prometheus_prompb_remote_proto_init() {
+	if File_prometheus_prompb_remote_proto != nil {
+		return
+	}
+	file_prometheus_prompb_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prometheus_prompb_remote_proto_rawDesc), len(file_prometheus_prompb_remote_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_prometheus_prompb_remote_proto_goTypes,
+		DependencyIndexes: file_prometheus_prompb_remote_proto_depIdxs,
+		MessageInfos:      file_prometheus_prompb_remote_proto_msgTypes,
+	}.Build()
+	File_prometheus_prompb_remote_proto = out.File
+	file_prometheus_prompb_remote_proto_goTypes = nil
+	file_prometheus_prompb_remote_proto_depIdxs = nil
+}
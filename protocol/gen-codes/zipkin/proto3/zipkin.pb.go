@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: zipkin/proto3/zipkin.proto
+
+package zipkin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Span_Kind int32
+
+const (
+	Span_SPAN_KIND_UNSPECIFIED Span_Kind = 0
+	Span_CLIENT                Span_Kind = 1
+	Span_SERVER                Span_Kind = 2
+	Span_PRODUCER              Span_Kind = 3
+	Span_CONSUMER              Span_Kind = 4
+)
+
+// Enum value maps for Span_Kind.
+var (
+	Span_Kind_name = map[int32]string{
+		0: "SPAN_KIND_UNSPECIFIED",
+		1: "CLIENT",
+		2: "SERVER",
+		3: "PRODUCER",
+		4: "CONSUMER",
+	}
+	Span_Kind_value = map[string]int32{
+		"SPAN_KIND_UNSPECIFIED": 0,
+		"CLIENT":                1,
+		"SERVER":                2,
+		"PRODUCER":              3,
+		"CONSUMER":              4,
+	}
+)
+
+func (x Span_Kind) Enum() *Span_Kind {
+	p := new(Span_Kind)
+	*p = x
+	return p
+}
+
+func (x Span_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Span_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_zipkin_proto3_zipkin_proto_enumTypes[0].Descriptor()
+}
+
+func (Span_Kind) Type() protoreflect.EnumType {
+	return &file_zipkin_proto3_zipkin_proto_enumTypes[0]
+}
+
+func (x Span_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Span_Kind.Descriptor instead.
+func (Span_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Span struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TraceId        []byte                 `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	ParentId       []byte                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Id             []byte                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Kind           Span_Kind              `protobuf:"varint,4,opt,name=kind,proto3,enum=zipkin.proto3.Span_Kind" json:"kind,omitempty"`
+	Name           string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp      uint64                 `protobuf:"fixed64,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration       uint64                 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	LocalEndpoint  *Endpoint              `protobuf:"bytes,8,opt,name=local_endpoint,json=localEndpoint,proto3" json:"local_endpoint,omitempty"`
+	RemoteEndpoint *Endpoint              `protobuf:"bytes,9,opt,name=remote_endpoint,json=remoteEndpoint,proto3" json:"remote_endpoint,omitempty"`
+	Annotations    []*Annotation          `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	Tags           map[string]string      `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Debug          bool                   `protobuf:"varint,12,opt,name=debug,proto3" json:"debug,omitempty"`
+	Shared         bool                   `protobuf:"varint,13,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Span) Reset() {
+	*x = Span{}
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Span) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Span) ProtoMessage() {}
+
+func (x *Span) ProtoReflect() protoreflect.Message {
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Span.ProtoReflect.Descriptor instead.
+func (*Span) Descriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Span) GetTraceId() []byte {
+	if x != nil {
+		return x.TraceId
+	}
+	return nil
+}
+
+func (x *Span) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *Span) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Span) GetKind() Span_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Span_SPAN_KIND_UNSPECIFIED
+}
+
+func (x *Span) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Span) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Span) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Span) GetLocalEndpoint() *Endpoint {
+	if x != nil {
+		return x.LocalEndpoint
+	}
+	return nil
+}
+
+func (x *Span) GetRemoteEndpoint() *Endpoint {
+	if x != nil {
+		return x.RemoteEndpoint
+	}
+	return nil
+}
+
+func (x *Span) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Span) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Span) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+func (x *Span) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type Endpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Ipv4          []byte                 `protobuf:"bytes,2,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6          []byte                 `protobuf:"bytes,3,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endpoint) Reset() {
+	*x = Endpoint{}
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endpoint) ProtoMessage() {}
+
+func (x *Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endpoint.ProtoReflect.Descriptor instead.
+func (*Endpoint) Descriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Endpoint) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Endpoint) GetIpv4() []byte {
+	if x != nil {
+		return x.Ipv4
+	}
+	return nil
+}
+
+func (x *Endpoint) GetIpv6() []byte {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+func (x *Endpoint) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type Annotation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     uint64                 `protobuf:"fixed64,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Annotation) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Annotation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ListOfSpans struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spans         []*Span                `protobuf:"bytes,1,rep,name=spans,proto3" json:"spans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOfSpans) Reset() {
+	*x = ListOfSpans{}
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOfSpans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOfSpans) ProtoMessage() {}
+
+func (x *ListOfSpans) ProtoReflect() protoreflect.Message {
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOfSpans.ProtoReflect.Descriptor instead.
+func (*ListOfSpans) Descriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOfSpans) GetSpans() []*Span {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+type ReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportResponse) Reset() {
+	*x = ReportResponse{}
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportResponse) ProtoMessage() {}
+
+func (x *ReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_zipkin_proto3_zipkin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
+func (*ReportResponse) Descriptor() ([]byte, []int) {
+	return file_zipkin_proto3_zipkin_proto_rawDescGZIP(), []int{4}
+}
+
+var File_zipkin_proto3_zipkin_proto protoreflect.FileDescriptor
+
+const file_zipkin_proto3_zipkin_proto_rawDesc = "" +
+	"\n" +
+	"\x1azipkin/proto3/zipkin.proto\x12\rzipkin.proto3\"\xfa\x04\n" +
+	"\x04Span\x12\x19\n" +
+	"\btrace_id\x18\x01 \x01(\fR\atraceId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\fR\bparentId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\fR\x02id\x12,\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x18.zipkin.proto3.Span.KindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x06R\ttimestamp\x12\x1a\n" +
+	"\bduration\x18\a \x01(\x04R\bduration\x12>\n" +
+	"\x0elocal_endpoint\x18\b \x01(\v2\x17.zipkin.proto3.EndpointR\rlocalEndpoint\x12@\n" +
+	"\x0fremote_endpoint\x18\t \x01(\v2\x17.zipkin.proto3.EndpointR\x0eremoteEndpoint\x12;\n" +
+	"\vannotations\x18\n" +
+	" \x03(\v2\x19.zipkin.proto3.AnnotationR\vannotations\x121\n" +
+	"\x04tags\x18\v \x03(\v2\x1d.zipkin.proto3.Span.TagsEntryR\x04tags\x12\x14\n" +
+	"\x05debug\x18\f \x01(\bR\x05debug\x12\x16\n" +
+	"\x06shared\x18\r \x01(\bR\x06shared\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x04Kind\x12\x19\n" +
+	"\x15SPAN_KIND_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLIENT\x10\x01\x12\n" +
+	"\n" +
+	"\x06SERVER\x10\x02\x12\f\n" +
+	"\bPRODUCER\x10\x03\x12\f\n" +
+	"\bCONSUMER\x10\x04\"i\n" +
+	"\bEndpoint\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12\x12\n" +
+	"\x04ipv4\x18\x02 \x01(\fR\x04ipv4\x12\x12\n" +
+	"\x04ipv6\x18\x03 \x01(\fR\x04ipv6\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\"@\n" +
+	"\n" +
+	"Annotation\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x06R\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"8\n" +
+	"\vListOfSpans\x12)\n" +
+	"\x05spans\x18\x01 \x03(\v2\x13.zipkin.proto3.SpanR\x05spans\"\x10\n" +
+	"\x0eReportResponse2R\n" +
+	"\vSpanService\x12C\n" +
+	"\x06Report\x12\x1a.zipkin.proto3.ListOfSpans\x1a\x1d.zipkin.proto3.ReportResponseBPZNgithub.com/apache/skywalking-satellite/protocol/gen-codes/zipkin/proto3;zipkinb\x06proto3"
+
+var (
+	file_zipkin_proto3_zipkin_proto_rawDescOnce sync.Once
+	file_zipkin_proto3_zipkin_proto_rawDescData []byte
+)
+
+func file_zipkin_proto3_zipkin_proto_rawDescGZIP() []byte {
+	file_zipkin_proto3_zipkin_proto_rawDescOnce.Do(func() {
+		file_zipkin_proto3_zipkin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_zipkin_proto3_zipkin_proto_rawDesc), len(file_zipkin_proto3_zipkin_proto_rawDesc)))
+	})
+	return file_zipkin_proto3_zipkin_proto_rawDescData
+}
+
+var file_zipkin_proto3_zipkin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_zipkin_proto3_zipkin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_zipkin_proto3_zipkin_proto_goTypes = []any{
+	(Span_Kind)(0),         // 0: zipkin.proto3.Span.Kind
+	(*Span)(nil),           // 1: zipkin.proto3.Span
+	(*Endpoint)(nil),       // 2: zipkin.proto3.Endpoint
+	(*Annotation)(nil),     // 3: zipkin.proto3.Annotation
+	(*ListOfSpans)(nil),    // 4: zipkin.proto3.ListOfSpans
+	(*ReportResponse)(nil), // 5: zipkin.proto3.ReportResponse
+	nil,                    // 6: zipkin.proto3.Span.TagsEntry
+}
+var file_zipkin_proto3_zipkin_proto_depIdxs = []int32{
+	0, // 0: zipkin.proto3.Span.kind:type_name -> zipkin.proto3.Span.Kind
+	2, // 1: zipkin.proto3.Span.local_endpoint:type_name -> zipkin.proto3.Endpoint
+	2, // 2: zipkin.proto3.Span.remote_endpoint:type_name -> zipkin.proto3.Endpoint
+	3, // 3: zipkin.proto3.Span.annotations:type_name -> zipkin.proto3.Annotation
+	6, // 4: zipkin.proto3.Span.tags:type_name -> zipkin.proto3.Span.TagsEntry
+	1, // 5: zipkin.proto3.ListOfSpans.spans:type_name -> zipkin.proto3.Span
+	4, // 6: zipkin.proto3.SpanService.Report:input_type -> zipkin.proto3.ListOfSpans
+	5, // 7: zipkin.proto3.SpanService.Report:output_type -> zipkin.proto3.ReportResponse
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_zipkin_proto3_zipkin_proto_init() }
+func file_zipkin_proto3_zipkin_proto_init() {
+	if File_zipkin_proto3_zipkin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_zipkin_proto3_zipkin_proto_rawDesc), len(file_zipkin_proto3_zipkin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_zipkin_proto3_zipkin_proto_goTypes,
+		DependencyIndexes: file_zipkin_proto3_zipkin_proto_depIdxs,
+		EnumInfos:         file_zipkin_proto3_zipkin_proto_enumTypes,
+		MessageInfos:      file_zipkin_proto3_zipkin_proto_msgTypes,
+	}.Build()
+	File_zipkin_proto3_zipkin_proto = out.File
+	file_zipkin_proto3_zipkin_proto_goTypes = nil
+	file_zipkin_proto3_zipkin_proto_depIdxs = nil
+}
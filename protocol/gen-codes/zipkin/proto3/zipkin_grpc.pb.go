@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: zipkin/proto3/zipkin.proto
+
+package zipkin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SpanServiceClient is the client API for SpanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SpanServiceClient interface {
+	// Report the provided spans to the collector. Analogous to the HTTP POST
+	// /api/v2/spans endpoint.
+	Report(ctx context.Context, in *ListOfSpans, opts ...grpc.CallOption) (*ReportResponse, error)
+}
+
+type spanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSpanServiceClient(cc grpc.ClientConnInterface) SpanServiceClient {
+	return &spanServiceClient{cc}
+}
+
+func (c *spanServiceClient) Report(ctx context.Context, in *ListOfSpans, opts ...grpc.CallOption) (*ReportResponse, error) {
+	out := new(ReportResponse)
+	err := c.cc.Invoke(ctx, "/zipkin.proto3.SpanService/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SpanServiceServer is the server API for SpanService service.
+// All implementations must embed UnimplementedSpanServiceServer
+// for forward compatibility
+type SpanServiceServer interface {
+	// Report the provided spans to the collector. Analogous to the HTTP POST
+	// /api/v2/spans endpoint.
+	Report(context.Context, *ListOfSpans) (*ReportResponse, error)
+	mustEmbedUnimplementedSpanServiceServer()
+}
+
+// UnimplementedSpanServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSpanServiceServer struct {
+}
+
+func (UnimplementedSpanServiceServer) Report(context.Context, *ListOfSpans) (*ReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (UnimplementedSpanServiceServer) mustEmbedUnimplementedSpanServiceServer() {}
+
+// UnsafeSpanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpanServiceServer will
+// result in compilation errors.
+type UnsafeSpanServiceServer interface {
+	mustEmbedUnimplementedSpanServiceServer()
+}
+
+func RegisterSpanServiceServer(s grpc.ServiceRegistrar, srv SpanServiceServer) {
+	s.RegisterService(&SpanService_ServiceDesc, srv)
+}
+
+func _SpanService_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOfSpans)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpanServiceServer).Report(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zipkin.proto3.SpanService/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpanServiceServer).Report(ctx, req.(*ListOfSpans))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SpanService_ServiceDesc is the grpc.ServiceDesc for SpanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SpanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zipkin.proto3.SpanService",
+	HandlerType: (*SpanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Report",
+			Handler:    _SpanService_Report_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zipkin/proto3/zipkin.proto",
+}